@@ -0,0 +1,159 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rivo/uniseg"
+)
+
+var _ function.Function = (*alignColumnsFunction)(nil)
+
+type alignColumnsFunction struct{}
+
+func NewAlignColumnsFunction() function.Function {
+	return &alignColumnsFunction{}
+}
+
+func (f *alignColumnsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "align_columns"
+}
+
+func (f *alignColumnsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Renders rows of cells as a text table with aligned columns",
+		Description: "Each column is as wide as its widest cell, measured in terminal columns, and rows may have different numbers of cells. Trailing spaces are removed from every line. " +
+			"Options: separator (default two spaces) goes between columns; align is a list with left, right or center for each column, and columns it does not cover are left-aligned; " +
+			"header underlines the first row with dashes.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.ListType{ElemType: types.StringType},
+				Name:        "rows",
+				Description: "The rows of the table, each a list of cells",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with separator, align and header attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *alignColumnsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rows [][]string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &rows, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseAlignColumnsOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := AlignColumns(rows, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// AlignColumnsOptions controls AlignColumns.
+type AlignColumnsOptions struct {
+	// Separator is placed between columns.
+	Separator string
+	// Align holds "left", "right" or "center" for each column. Columns
+	// beyond its end are left-aligned.
+	Align []string
+	// Header underlines the first row.
+	Header bool
+}
+
+// DefaultAlignColumnsOptions returns options for left-aligned columns
+// separated by two spaces.
+func DefaultAlignColumnsOptions() AlignColumnsOptions {
+	return AlignColumnsOptions{Separator: "  "}
+}
+
+func parseAlignColumnsOptions(args []types.Dynamic) (AlignColumnsOptions, error) {
+	opts, err := parseOptions(args, "separator", "align", "header")
+	if err != nil {
+		return AlignColumnsOptions{}, err
+	}
+
+	result := DefaultAlignColumnsOptions()
+	if result.Separator, err = opts.String("separator", result.Separator); err != nil {
+		return AlignColumnsOptions{}, err
+	}
+	if result.Align, err = opts.Strings("align"); err != nil {
+		return AlignColumnsOptions{}, err
+	}
+	if result.Header, err = opts.Bool("header", result.Header); err != nil {
+		return AlignColumnsOptions{}, err
+	}
+	return result, nil
+}
+
+// AlignColumns pads the cells of rows so that columns line up and joins the
+// rows with newlines.
+func AlignColumns(rows [][]string, opts AlignColumnsOptions) (string, error) {
+	for _, a := range opts.Align {
+		if a != "left" && a != "right" && a != "center" {
+			return "", fmt.Errorf("unsupported alignment %q, expected one of: left, right, center", a)
+		}
+	}
+
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], uniseg.StringWidth(cell))
+		}
+	}
+
+	lines := make([]string, 0, len(rows)+1)
+	for r, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			align := "left"
+			if i < len(opts.Align) {
+				align = opts.Align[i]
+			}
+			cells[i] = padCell(cell, widths[i], align)
+		}
+		lines = append(lines, strings.TrimRight(strings.Join(cells, opts.Separator), " "))
+
+		if r == 0 && opts.Header {
+			rules := make([]string, len(widths))
+			for i, w := range widths {
+				rules[i] = strings.Repeat("-", w)
+			}
+			lines = append(lines, strings.TrimRight(strings.Join(rules, opts.Separator), " "))
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// padCell pads s with spaces to width columns. Centered text leans left
+// when the padding cannot be split evenly.
+func padCell(s string, width int, align string) string {
+	padding := width - uniseg.StringWidth(s)
+	switch align {
+	case "right":
+		return strings.Repeat(" ", padding) + s
+	case "center":
+		return strings.Repeat(" ", padding/2) + s + strings.Repeat(" ", padding-padding/2)
+	default:
+		return s + strings.Repeat(" ", padding)
+	}
+}
@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestAlignColumns(t *testing.T) {
+	rows := [][]string{
+		{"NAME", "CPU", "REGION"},
+		{"web", "2", "eu-west-1"},
+		{"database", "16", "us-east-1"},
+	}
+
+	tests := []struct {
+		name    string
+		rows    [][]string
+		opts    AlignColumnsOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "left aligned",
+			rows: rows,
+			opts: DefaultAlignColumnsOptions(),
+			want: "NAME      CPU  REGION\n" +
+				"web       2    eu-west-1\n" +
+				"database  16   us-east-1",
+		},
+		{
+			name: "right and center with header",
+			rows: rows,
+			opts: AlignColumnsOptions{Separator: " | ", Align: []string{"left", "right", "center"}, Header: true},
+			want: "NAME     | CPU |  REGION\n" +
+				"-------- | --- | ---------\n" +
+				"web      |   2 | eu-west-1\n" +
+				"database |  16 | us-east-1",
+		},
+		{
+			name: "ragged rows",
+			rows: [][]string{{"a", "b", "c"}, {"long"}, {}},
+			opts: DefaultAlignColumnsOptions(),
+			want: "a     b  c\nlong\n",
+		},
+		{
+			name: "wide characters",
+			rows: [][]string{{"名前", "x"}, {"ab", "y"}},
+			opts: DefaultAlignColumnsOptions(),
+			want: "名前  x\nab    y",
+		},
+		{name: "no rows", opts: DefaultAlignColumnsOptions(), want: ""},
+		{name: "unknown alignment", rows: rows, opts: AlignColumnsOptions{Align: []string{"justify"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AlignColumns(tt.rows, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AlignColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("AlignColumns() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlignColumnsFunction_Run(t *testing.T) {
+	f := NewAlignColumnsFunction()
+	rowType := types.ListType{ElemType: types.StringType}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ListValueMust(rowType, []attr.Value{
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("key"), types.StringValue("value")}),
+				types.ListValueMust(types.StringType, []attr.Value{types.StringValue("region"), types.StringValue("eu")}),
+			}),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), "key     value\nregion  eu")
+}
@@ -0,0 +1,158 @@
+package functions
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+)
+
+// parseCIDR parses s as a network prefix such as 10.0.0.0/16 or
+// 2001:db8::/32. Host bits must be zero, since a prefix like 10.0.0.1/16 is
+// usually a mistake in a network plan.
+func parseCIDR(s string) (netip.Prefix, error) {
+	p, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q", s)
+	}
+	if p.Addr().Zone() != "" {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: zones are not supported", s)
+	}
+	if m := p.Masked(); m != p {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR %q: host bits are set, did you mean %s?", s, m)
+	}
+	return p, nil
+}
+
+// parseAddr parses an IP address without a zone.
+func parseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return netip.Addr{}, fmt.Errorf("invalid IP address %q", s)
+	}
+	return addr, nil
+}
+
+// parseCIDRs parses every element of list with parseCIDR, naming the
+// position of the first invalid one.
+func parseCIDRs(list []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(list))
+	for i, s := range list {
+		p, err := parseCIDR(s)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		prefixes = append(prefixes, p)
+	}
+	return prefixes, nil
+}
+
+// prefixContains reports whether inner lies entirely within outer.
+func prefixContains(outer, inner netip.Prefix) bool {
+	return outer.Addr().Is4() == inner.Addr().Is4() && outer.Bits() <= inner.Bits() && outer.Contains(inner.Addr())
+}
+
+// prefixHalves splits p into its lower and upper halves. p must be masked
+// and shorter than a single address.
+func prefixHalves(p netip.Prefix) (lower, upper netip.Prefix) {
+	bits := p.Bits() + 1
+	raw := p.Addr().AsSlice()
+	lower = netip.PrefixFrom(p.Addr(), bits)
+	raw[(bits-1)/8] |= 0x80 >> ((bits - 1) % 8)
+	addr, _ := netip.AddrFromSlice(raw)
+	return lower, netip.PrefixFrom(addr, bits)
+}
+
+// excludePrefixes returns the smallest set of prefixes covering the
+// addresses of base that are not in any of excluded, ordered by address.
+func excludePrefixes(base netip.Prefix, excluded []netip.Prefix) []netip.Prefix {
+	overlapping := false
+	for _, e := range excluded {
+		if prefixContains(e, base) {
+			return nil
+		}
+		if e.Overlaps(base) {
+			overlapping = true
+		}
+	}
+	if !overlapping {
+		return []netip.Prefix{base}
+	}
+	lower, upper := prefixHalves(base)
+	return append(excludePrefixes(lower, excluded), excludePrefixes(upper, excluded)...)
+}
+
+// mergePrefixes returns the smallest set of prefixes covering the same
+// addresses as prefixes, IPv4 before IPv6 and ordered by address. Prefixes
+// contained in others are dropped and sibling halves are joined into their
+// parent until no two can be joined.
+func mergePrefixes(prefixes []netip.Prefix) []netip.Prefix {
+	sorted := slices.Clone(prefixes)
+	slices.SortFunc(sorted, func(a, b netip.Prefix) int {
+		if c := a.Addr().Compare(b.Addr()); c != 0 {
+			return c
+		}
+		return a.Bits() - b.Bits()
+	})
+
+	var merged []netip.Prefix
+	for _, p := range sorted {
+		// Sorting puts a prefix after any prefix containing it, and merged
+		// is disjoint, so only the last entry can contain p.
+		if n := len(merged); n > 0 && prefixContains(merged[n-1], p) {
+			continue
+		}
+		merged = append(merged, p)
+		for n := len(merged); n >= 2 && prefixSiblings(merged[n-2], merged[n-1]); n = len(merged) {
+			merged = append(merged[:n-2], netip.PrefixFrom(merged[n-2].Addr(), merged[n-2].Bits()-1))
+		}
+	}
+	return merged
+}
+
+// prefixSiblings reports whether a and b are the two halves of one prefix.
+func prefixSiblings(a, b netip.Prefix) bool {
+	if a == b || a.Bits() != b.Bits() || a.Bits() == 0 || a.Addr().Is4() != b.Addr().Is4() {
+		return false
+	}
+	parent := netip.PrefixFrom(a.Addr(), a.Bits()-1).Masked()
+	return parent == netip.PrefixFrom(b.Addr(), b.Bits()-1).Masked()
+}
+
+// prefixLast returns the highest address of p.
+func prefixLast(p netip.Prefix) netip.Addr {
+	raw := p.Masked().Addr().AsSlice()
+	for i := p.Bits(); i < len(raw)*8; i++ {
+		raw[i/8] |= 0x80 >> (i % 8)
+	}
+	addr, _ := netip.AddrFromSlice(raw)
+	return addr
+}
+
+// addrToInt returns addr as an unsigned integer.
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+// intToAddr converts n to an IPv4 address, or an IPv6 address unless is4.
+// ok is false if n is negative or too large for the address family.
+func intToAddr(n *big.Int, is4 bool) (addr netip.Addr, ok bool) {
+	size := 16
+	if is4 {
+		size = 4
+	}
+	if n.Sign() < 0 || n.BitLen() > size*8 {
+		return netip.Addr{}, false
+	}
+	return netip.AddrFromSlice(n.FillBytes(make([]byte, size)))
+}
+
+// prefixStrings formats prefixes, returning an empty rather than nil slice
+// so that Terraform sees an empty list.
+func prefixStrings(prefixes []netip.Prefix) []string {
+	result := make([]string, 0, len(prefixes))
+	for _, p := range prefixes {
+		result = append(result, p.String())
+	}
+	return result
+}
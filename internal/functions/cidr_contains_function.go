@@ -0,0 +1,93 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*cidrContainsFunction)(nil)
+
+type cidrContainsFunction struct{}
+
+func NewCIDRContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+func (f *cidrContainsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+func (f *cidrContainsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether a CIDR block or IP address lies entirely within another CIDR block",
+		Description: "A block contains itself. An IPv4 block never contains an IPv6 address or block, or the reverse.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "outer",
+				Description: "The enclosing CIDR block",
+			},
+			function.StringParameter{
+				Name:        "inner",
+				Description: "The CIDR block or IP address to look for",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var outer, inner string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &outer, &inner))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate each argument first so that errors point at the one at fault.
+	if _, err := parseCIDR(outer); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if _, err := parseCIDROrAddr(inner); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := CIDRContains(outer, inner)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// CIDRContains reports whether inner, a CIDR block or IP address, lies
+// entirely within the CIDR block outer.
+func CIDRContains(outer, inner string) (bool, error) {
+	outerPrefix, err := parseCIDR(outer)
+	if err != nil {
+		return false, err
+	}
+	innerPrefix, err := parseCIDROrAddr(inner)
+	if err != nil {
+		return false, err
+	}
+	return prefixContains(outerPrefix, innerPrefix), nil
+}
+
+// parseCIDROrAddr parses a CIDR block, or an IP address as a block of one
+// address.
+func parseCIDROrAddr(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		return parseCIDR(s)
+	}
+	addr, err := parseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR or IP address %q", s)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
@@ -0,0 +1,87 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRContains(t *testing.T) {
+	tests := []struct {
+		name         string
+		outer, inner string
+		want         bool
+		wantErr      bool
+	}{
+		{name: "subnet", outer: "10.0.0.0/16", inner: "10.0.4.0/24", want: true},
+		{name: "itself", outer: "10.0.0.0/16", inner: "10.0.0.0/16", want: true},
+		{name: "larger", outer: "10.0.0.0/16", inner: "10.0.0.0/8"},
+		{name: "partial overlap is not containment", outer: "10.0.0.0/25", inner: "10.0.0.0/24"},
+		{name: "outside", outer: "10.0.0.0/16", inner: "10.1.0.0/24"},
+		{name: "address", outer: "10.0.0.0/16", inner: "10.0.255.255", want: true},
+		{name: "address outside", outer: "10.0.0.0/16", inner: "10.1.0.0"},
+		{name: "ipv6", outer: "2001:db8::/32", inner: "2001:db8:1234::/48", want: true},
+		{name: "ipv6 address", outer: "2001:db8::/32", inner: "2001:db8::1", want: true},
+		{name: "mixed families", outer: "::/0", inner: "10.0.0.0/8"},
+		{name: "ipv4-mapped ipv6 is ipv6", outer: "0.0.0.0/0", inner: "::ffff:10.0.0.1"},
+		{name: "invalid outer", outer: "10.0.0.1/16", inner: "10.0.0.0/24", wantErr: true},
+		{name: "invalid inner", outer: "10.0.0.0/16", inner: "10.0.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRContains(tt.outer, tt.inner)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDRContains() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("CIDRContains(%q, %q) = %v, want %v", tt.outer, tt.inner, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRContainsFunction_Run(t *testing.T) {
+	f := NewCIDRContainsFunction()
+
+	tests := []struct {
+		name         string
+		outer, inner string
+		want         bool
+		wantErr      bool
+		errArg       int64
+	}{
+		{name: "contained", outer: "10.0.0.0/16", inner: "10.0.1.0/24", want: true},
+		{name: "not contained", outer: "10.0.0.0/16", inner: "10.1.0.0/24"},
+		{name: "invalid outer", outer: "10.0.0.1/16", inner: "10.0.1.0/24", wantErr: true, errArg: 0},
+		{name: "invalid inner", outer: "10.0.0.0/16", inner: "nope", wantErr: true, errArg: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.outer), types.StringValue(tt.inner)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewBoolNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != tt.errArg {
+					t.Errorf("Run() error argument = %v, want %d", resp.Error.FunctionArgument, tt.errArg)
+				}
+				return
+			}
+			if got := resp.Result.Value().(basetypes.BoolValue).ValueBool(); got != tt.want {
+				t.Errorf("Run() result = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrExcludeFunction)(nil)
+
+type cidrExcludeFunction struct{}
+
+func NewCIDRExcludeFunction() function.Function {
+	return &cidrExcludeFunction{}
+}
+
+func (f *cidrExcludeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_exclude"
+}
+
+func (f *cidrExcludeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Removes CIDR blocks from a network, returning the blocks that remain",
+		Description: "The result is the fewest blocks covering the addresses of base outside every excluded block, ordered by address; it is empty when nothing remains. " +
+			"Excluded blocks may extend beyond base, and blocks of the other IP version are ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base",
+				Description: "The network to remove blocks from",
+			},
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "excluded",
+				Description: "The CIDR blocks to remove",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *cidrExcludeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var base string
+	var excluded []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &base, &excluded))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate each argument first so that errors point at the one at fault.
+	if _, err := parseCIDR(base); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if _, err := parseCIDRs(excluded); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := CIDRExclude(base, excluded)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// CIDRExclude returns the smallest list of CIDR blocks covering the addresses
+// of base that are in none of excluded.
+func CIDRExclude(base string, excluded []string) ([]string, error) {
+	basePrefix, err := parseCIDR(base)
+	if err != nil {
+		return nil, err
+	}
+	excludedPrefixes, err := parseCIDRs(excluded)
+	if err != nil {
+		return nil, err
+	}
+	return prefixStrings(excludePrefixes(basePrefix, excludedPrefixes)), nil
+}
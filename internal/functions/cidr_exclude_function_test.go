@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRExclude(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		excluded []string
+		want     []string
+		wantErr  bool
+	}{
+		{name: "carve out subnet", base: "10.0.0.0/16", excluded: []string{"10.0.0.0/18"}, want: []string{"10.0.64.0/18", "10.0.128.0/17"}},
+		{name: "several", base: "10.0.0.0/24", excluded: []string{"10.0.0.0/26", "10.0.0.192/26"}, want: []string{"10.0.0.64/26", "10.0.0.128/26"}},
+		{name: "excluded extends past base", base: "10.0.0.0/24", excluded: []string{"10.0.0.0/8"}, want: []string{}},
+		{name: "ipv6", base: "2001:db8::/32", excluded: []string{"2001:db8:8000::/33"}, want: []string{"2001:db8::/33"}},
+		{name: "other family ignored", base: "10.0.0.0/24", excluded: []string{"2001:db8::/32"}, want: []string{"10.0.0.0/24"}},
+		{name: "invalid base", base: "10.0.0.0/33", wantErr: true},
+		{name: "invalid excluded", base: "10.0.0.0/24", excluded: []string{"x"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRExclude(tt.base, tt.excluded)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDRExclude() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("CIDRExclude(%s, %v) = %v, want %v", tt.base, tt.excluded, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRExcludeFunction_Run(t *testing.T) {
+	f := NewCIDRExcludeFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("10.0.0.0/23"),
+			types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.1.0/24")}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24")})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("10.0.0.0/23"),
+		types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.1.1/24")}),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Fatal("expected error for invalid excluded block")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+		t.Errorf("error argument = %v, want 1", resp.Error.FunctionArgument)
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("10.0.0.0/33"),
+		types.ListValueMust(types.StringType, []attr.Value{}),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Fatal("expected error for invalid base")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("error argument = %v, want 0", resp.Error.FunctionArgument)
+	}
+}
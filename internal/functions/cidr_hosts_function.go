@@ -0,0 +1,194 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrHostsFunction)(nil)
+
+type cidrHostsFunction struct{}
+
+func NewCIDRHostsFunction() function.Function {
+	return &cidrHostsFunction{}
+}
+
+func (f *cidrHostsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_hosts"
+}
+
+func (f *cidrHostsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Lists the usable host addresses of a CIDR block, skipping reserved addresses",
+		Description: "Options: reserved selects the addresses that are not usable: standard (the default) skips the network and broadcast addresses of IPv4 blocks larger than /31; " +
+			"aws and azure skip the first four addresses and the last; gcp skips the first two and the last two; none skips nothing. " +
+			"offset (default 0) is the index of the first usable host to return, counting from the end when negative, so -1 is the last usable host; " +
+			"count (default all remaining) limits the number of hosts returned. At most " + fmt.Sprint(cidrHostsMaxCount) + " hosts are returned, so count is needed for large blocks. " +
+			"For example, the tenth usable host of an AWS subnet is cidr_hosts(cidr, { reserved = \"aws\", offset = 9, count = 1 })[0].",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The CIDR block",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with reserved, offset and count attributes",
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *cidrHostsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseCIDR(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	opts, err := parseCIDRHostsOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := CIDRHosts(prefix, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cidrHostsMaxCount bounds the number of addresses CIDRHosts returns.
+const cidrHostsMaxCount = 65536
+
+// hostReservations returns the number of unusable addresses at the start
+// and end of a block under each convention.
+var hostReservations = map[string]func(p netip.Prefix) (first, last int){
+	"none": func(netip.Prefix) (int, int) { return 0, 0 },
+	"standard": func(p netip.Prefix) (int, int) {
+		if p.Addr().Is4() && p.Bits() <= 30 {
+			return 1, 1
+		}
+		return 0, 0
+	},
+	// The network address, VPC router, DNS server, a future use address
+	// and the broadcast address.
+	"aws": func(netip.Prefix) (int, int) { return 4, 1 },
+	// The network address, default gateway, two DNS addresses and the
+	// broadcast address.
+	"azure": func(netip.Prefix) (int, int) { return 4, 1 },
+	// The network address, default gateway, second-to-last address and
+	// broadcast address.
+	"gcp": func(netip.Prefix) (int, int) { return 2, 2 },
+}
+
+func hostReservationNames() []string {
+	names := make([]string, 0, len(hostReservations))
+	for name := range hostReservations {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// CIDRHostsOptions controls CIDRHosts.
+type CIDRHostsOptions struct {
+	// Reserved names the reserved-address convention.
+	Reserved string
+	// Offset is the index of the first usable host returned. Negative
+	// values count from the end.
+	Offset int
+	// Count is the maximum number of hosts returned, or -1 for all.
+	Count int
+}
+
+// DefaultCIDRHostsOptions returns options listing every host usable under
+// the standard convention.
+func DefaultCIDRHostsOptions() CIDRHostsOptions {
+	return CIDRHostsOptions{Reserved: "standard", Count: -1}
+}
+
+func parseCIDRHostsOptions(args []types.Dynamic) (CIDRHostsOptions, error) {
+	opts, err := parseOptions(args, "reserved", "offset", "count")
+	if err != nil {
+		return CIDRHostsOptions{}, err
+	}
+
+	result := DefaultCIDRHostsOptions()
+	if result.Reserved, err = opts.String("reserved", result.Reserved); err != nil {
+		return CIDRHostsOptions{}, err
+	}
+	if result.Offset, err = opts.Int("offset", result.Offset); err != nil {
+		return CIDRHostsOptions{}, err
+	}
+	if result.Count, err = opts.Int("count", result.Count); err != nil {
+		return CIDRHostsOptions{}, err
+	}
+	if _, ok := opts["count"]; ok && result.Count < 0 {
+		return CIDRHostsOptions{}, fmt.Errorf("count must not be negative, got %d", result.Count)
+	}
+	return result, nil
+}
+
+// CIDRHosts returns the usable addresses of p, from the host at
+// opts.Offset on. Offsets work like slice bounds: they may range from
+// minus to plus the number of usable hosts.
+func CIDRHosts(p netip.Prefix, opts CIDRHostsOptions) ([]string, error) {
+	reservation, ok := hostReservations[opts.Reserved]
+	if !ok {
+		return nil, fmt.Errorf("unsupported reserved convention %q, expected one of: %s", opts.Reserved, strings.Join(hostReservationNames(), ", "))
+	}
+	reservedFirst, reservedLast := reservation(p)
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(p.Addr().BitLen()-p.Bits()))
+	usable := size.Sub(size, big.NewInt(int64(reservedFirst+reservedLast)))
+	if usable.Sign() < 0 {
+		usable.SetInt64(0)
+	}
+
+	offset := big.NewInt(int64(opts.Offset))
+	if offset.Sign() < 0 {
+		offset.Add(offset, usable)
+	}
+	if offset.Sign() < 0 || offset.Cmp(usable) > 0 {
+		return nil, fmt.Errorf("offset %d is out of range, %s has %s usable hosts", opts.Offset, p, usable)
+	}
+
+	remaining := new(big.Int).Sub(usable, offset)
+	count := remaining
+	if opts.Count >= 0 && big.NewInt(int64(opts.Count)).Cmp(remaining) < 0 {
+		count = big.NewInt(int64(opts.Count))
+	}
+	if count.Cmp(big.NewInt(cidrHostsMaxCount)) > 0 {
+		return nil, fmt.Errorf("%s has %s usable hosts from offset %d, set count to at most %d", p, remaining, opts.Offset, cidrHostsMaxCount)
+	}
+
+	first := addrToInt(p.Addr())
+	first.Add(first, big.NewInt(int64(reservedFirst)))
+	first.Add(first, offset)
+	addr, _ := intToAddr(first, p.Addr().Is4())
+
+	hosts := make([]string, 0, count.Int64())
+	for range count.Int64() {
+		hosts = append(hosts, addr.String())
+		addr = addr.Next()
+	}
+	return hosts, nil
+}
@@ -0,0 +1,130 @@
+package functions
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRHosts(t *testing.T) {
+	withOpts := func(reserved string, offset, count int) CIDRHostsOptions {
+		return CIDRHostsOptions{Reserved: reserved, Offset: offset, Count: count}
+	}
+
+	tests := []struct {
+		name    string
+		cidr    string
+		opts    CIDRHostsOptions
+		want    []string
+		wantErr bool
+	}{
+		{name: "standard", cidr: "10.0.0.0/29", opts: DefaultCIDRHostsOptions(), want: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6"}},
+		{name: "standard point-to-point", cidr: "10.0.0.0/31", opts: DefaultCIDRHostsOptions(), want: []string{"10.0.0.0", "10.0.0.1"}},
+		{name: "standard single address", cidr: "10.0.0.9/32", opts: DefaultCIDRHostsOptions(), want: []string{"10.0.0.9"}},
+		{name: "none", cidr: "10.0.0.0/30", opts: withOpts("none", 0, -1), want: []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+		{name: "aws", cidr: "10.0.0.0/28", opts: withOpts("aws", 0, -1), want: []string{
+			"10.0.0.4", "10.0.0.5", "10.0.0.6", "10.0.0.7", "10.0.0.8", "10.0.0.9", "10.0.0.10", "10.0.0.11", "10.0.0.12", "10.0.0.13", "10.0.0.14",
+		}},
+		{name: "aws tenth host", cidr: "10.0.1.0/24", opts: withOpts("aws", 9, 1), want: []string{"10.0.1.13"}},
+		{name: "azure last host", cidr: "10.0.1.0/24", opts: withOpts("azure", -1, -1), want: []string{"10.0.1.254"}},
+		{name: "gcp", cidr: "10.0.0.0/29", opts: withOpts("gcp", 0, -1), want: []string{"10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5"}},
+		{name: "count clamps to remaining", cidr: "10.0.0.0/29", opts: withOpts("standard", 4, 10), want: []string{"10.0.0.5", "10.0.0.6"}},
+		{name: "offset at end", cidr: "10.0.0.0/29", opts: withOpts("standard", 6, -1), want: []string{}},
+		{name: "nothing usable", cidr: "10.0.0.0/30", opts: withOpts("aws", 0, -1), want: []string{}},
+		{name: "ipv6 large block with count", cidr: "2001:db8::/64", opts: withOpts("aws", 0, 2), want: []string{"2001:db8::4", "2001:db8::5"}},
+		{name: "ipv6 last host", cidr: "2001:db8::/64", opts: withOpts("none", -1, 1), want: []string{"2001:db8::ffff:ffff:ffff:ffff"}},
+		{name: "too many hosts", cidr: "10.0.0.0/8", opts: DefaultCIDRHostsOptions(), wantErr: true},
+		{name: "offset out of range", cidr: "10.0.0.0/29", opts: withOpts("standard", 7, -1), wantErr: true},
+		{name: "negative offset out of range", cidr: "10.0.0.0/29", opts: withOpts("standard", -7, -1), wantErr: true},
+		{name: "unknown convention", cidr: "10.0.0.0/29", opts: withOpts("oracle", 0, -1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRHosts(netip.MustParsePrefix(tt.cidr), tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDRHosts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("CIDRHosts(%s) = %v, want %v", tt.cidr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRHostsFunction_Run(t *testing.T) {
+	f := NewCIDRHostsFunction()
+	options := func(attrs map[string]attr.Value) attr.Value {
+		attrTypes := map[string]attr.Type{}
+		for name, v := range attrs {
+			attrTypes[name] = v.Type(context.Background())
+		}
+		return types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+			types.DynamicValue(types.ObjectValueMust(attrTypes, attrs)),
+		})
+	}
+
+	tests := []struct {
+		name    string
+		cidr    string
+		options attr.Value
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "aws tenth host",
+			cidr:    "10.0.1.0/24",
+			options: options(map[string]attr.Value{"reserved": types.StringValue("aws"), "offset": types.NumberValue(bigFloat(9)), "count": types.NumberValue(bigFloat(1))}),
+			want:    []string{"10.0.1.13"},
+		},
+		{
+			name:    "defaults",
+			cidr:    "10.0.0.0/30",
+			options: types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+			want:    []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			name:    "negative count",
+			cidr:    "10.0.0.0/30",
+			options: options(map[string]attr.Value{"count": types.NumberValue(bigFloat(-1))}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid cidr",
+			cidr:    "10.0.0.1/30",
+			options: types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.cidr), tt.options}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var got []string
+			for _, e := range resp.Result.Value().(basetypes.ListValue).Elements() {
+				got = append(got, e.(basetypes.StringValue).ValueString())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Run() result = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrMergeFunction)(nil)
+
+type cidrMergeFunction struct{}
+
+func NewCIDRMergeFunction() function.Function {
+	return &cidrMergeFunction{}
+}
+
+func (f *cidrMergeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_merge"
+}
+
+func (f *cidrMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Summarizes a list of CIDR blocks into the fewest blocks covering exactly the same addresses",
+		Description: "Duplicates and blocks within other blocks are dropped, and adjacent blocks that together form a larger block are joined, e.g. 10.0.0.0/25 and 10.0.0.128/25 become 10.0.0.0/24. " +
+			"No address outside the input is added. The result lists IPv4 blocks before IPv6 blocks, each ordered by address.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "cidrs",
+				Description: "The CIDR blocks to merge",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *cidrMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := CIDRMerge(cidrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// CIDRMerge returns the smallest list of CIDR blocks covering the same
+// addresses as cidrs.
+func CIDRMerge(cidrs []string) ([]string, error) {
+	prefixes, err := parseCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	return prefixStrings(mergePrefixes(prefixes)), nil
+}
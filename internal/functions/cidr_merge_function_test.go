@@ -0,0 +1,68 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRMerge(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "siblings", cidrs: []string{"10.0.0.128/25", "10.0.0.0/25"}, want: []string{"10.0.0.0/24"}},
+		{name: "cascading", cidrs: []string{"10.0.0.0/26", "10.0.0.64/26", "10.0.0.128/25", "10.0.1.0/24"}, want: []string{"10.0.0.0/23"}},
+		{name: "adjacent but not siblings", cidrs: []string{"10.0.1.0/24", "10.0.2.0/24"}, want: []string{"10.0.1.0/24", "10.0.2.0/24"}},
+		{name: "contained and duplicate", cidrs: []string{"10.0.5.0/24", "10.0.0.0/16", "10.0.0.0/16"}, want: []string{"10.0.0.0/16"}},
+		{name: "contained before merge", cidrs: []string{"10.0.0.0/25", "10.0.0.64/26", "10.0.0.128/25"}, want: []string{"10.0.0.0/24"}},
+		{name: "single addresses", cidrs: []string{"192.168.0.3/32", "192.168.0.0/32", "192.168.0.2/32", "192.168.0.1/32"}, want: []string{"192.168.0.0/30"}},
+		{name: "families kept apart", cidrs: []string{"2001:db8:1::/48", "10.0.0.0/8", "2001:db8::/48"}, want: []string{"10.0.0.0/8", "2001:db8::/47"}},
+		{name: "halves of everything", cidrs: []string{"128.0.0.0/1", "0.0.0.0/1"}, want: []string{"0.0.0.0/0"}},
+		{name: "empty", want: []string{}},
+		{name: "invalid", cidrs: []string{"10.0.0.1/24"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRMerge(tt.cidrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDRMerge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("CIDRMerge(%v) = %v, want %v", tt.cidrs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDRMergeFunction_Run(t *testing.T) {
+	f := NewCIDRMergeFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("10.0.1.0/24"),
+				types.StringValue("10.0.0.0/24"),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/23")})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrOverlapsFunction)(nil)
+
+type cidrOverlapsFunction struct{}
+
+func NewCIDROverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+func (f *cidrOverlapsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *cidrOverlapsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Finds the pairs of CIDR blocks in a list that share addresses",
+		Description: "Returns a list of objects with a and b attributes, the overlapping blocks in the order they appear in the list; an empty list means no block overlaps another. " +
+			"IPv4 and IPv6 blocks may be mixed and never overlap each other.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "cidrs",
+				Description: "The CIDR blocks to check",
+			},
+		},
+		Return: function.ListReturn{ElementType: cidrOverlapType},
+	}
+}
+
+var cidrOverlapType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"a": types.StringType,
+	"b": types.StringType,
+}}
+
+func (f *cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := CIDROverlaps(cidrs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// CIDROverlap is a pair of overlapping CIDR blocks.
+type CIDROverlap struct {
+	A string `tfsdk:"a"`
+	B string `tfsdk:"b"`
+}
+
+// CIDROverlaps returns every pair of blocks in cidrs that share at least one
+// address, with A listed before B in cidrs.
+func CIDROverlaps(cidrs []string) ([]CIDROverlap, error) {
+	prefixes, err := parseCIDRs(cidrs)
+	if err != nil {
+		return nil, err
+	}
+
+	overlaps := []CIDROverlap{}
+	for i, a := range prefixes {
+		for j := i + 1; j < len(prefixes); j++ {
+			if a.Overlaps(prefixes[j]) {
+				overlaps = append(overlaps, CIDROverlap{A: cidrs[i], B: cidrs[j]})
+			}
+		}
+	}
+	return overlaps, nil
+}
@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDROverlaps(t *testing.T) {
+	tests := []struct {
+		name    string
+		cidrs   []string
+		want    []CIDROverlap
+		wantErr bool
+	}{
+		{name: "disjoint", cidrs: []string{"10.0.0.0/24", "10.0.1.0/24", "2001:db8::/32"}, want: []CIDROverlap{}},
+		{name: "nested", cidrs: []string{"10.0.0.0/16", "192.168.0.0/24", "10.0.5.0/24"}, want: []CIDROverlap{{A: "10.0.0.0/16", B: "10.0.5.0/24"}}},
+		{name: "duplicates", cidrs: []string{"10.0.0.0/24", "10.0.0.0/24"}, want: []CIDROverlap{{A: "10.0.0.0/24", B: "10.0.0.0/24"}}},
+		{
+			name:  "several pairs",
+			cidrs: []string{"10.0.0.128/25", "10.0.0.0/24", "10.0.0.192/26"},
+			want: []CIDROverlap{
+				{A: "10.0.0.128/25", B: "10.0.0.0/24"},
+				{A: "10.0.0.128/25", B: "10.0.0.192/26"},
+				{A: "10.0.0.0/24", B: "10.0.0.192/26"},
+			},
+		},
+		{name: "ipv6", cidrs: []string{"2001:db8::/48", "2001:db8:0:ff00::/56"}, want: []CIDROverlap{{A: "2001:db8::/48", B: "2001:db8:0:ff00::/56"}}},
+		{name: "families never overlap", cidrs: []string{"0.0.0.0/0", "::/0"}, want: []CIDROverlap{}},
+		{name: "empty", want: []CIDROverlap{}},
+		{name: "invalid", cidrs: []string{"10.0.0.0/24", "10.0.0.0/33"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDROverlaps(tt.cidrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDROverlaps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("CIDROverlaps(%v) = %v, want %v", tt.cidrs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIDROverlapsFunction_Run(t *testing.T) {
+	f := NewCIDROverlapsFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("10.0.0.0/16"),
+				types.StringValue("10.0.1.0/24"),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(cidrOverlapType))}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(cidrOverlapType, []attr.Value{
+		types.ObjectValueMust(cidrOverlapType.AttrTypes, map[string]attr.Value{
+			"a": types.StringValue("10.0.0.0/16"),
+			"b": types.StringValue("10.0.1.0/24"),
+		}),
+	})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,240 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cidrPlanFunction)(nil)
+
+type cidrPlanFunction struct{}
+
+func NewCIDRPlanFunction() function.Function {
+	return &cidrPlanFunction{}
+}
+
+func (f *cidrPlanFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_plan"
+}
+
+func (f *cidrPlanFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Allocates named subnets of different sizes within a network without overlap",
+		Description: "Each request is either a prefix length such as \"/24\", or a number of hosts, which is rounded up to the smallest subnet holding that many hosts plus reserved_hosts. " +
+			"Subnets are allocated largest first, then by name, each at the lowest free address, so the same requests always give the same plan. " +
+			"Returns an object with subnets, a map of name to CIDR, and free, the unallocated blocks of the network ordered by address. " +
+			"Options: reserved_hosts (default 2, for the network and broadcast addresses) is added to host counts; " +
+			"previous is the subnets map of an earlier plan, whose subnets are kept where they are as long as they are still requested with the same size, so that adding a subnet never moves existing ones.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base_cidr",
+				Description: "The network to allocate subnets from",
+			},
+			function.MapParameter{
+				ElementType: types.StringType,
+				Name:        "requests",
+				Description: "A map of subnet name to a prefix length such as \"/24\" or a number of hosts",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with reserved_hosts and previous attributes",
+		},
+		Return: function.ObjectReturn{AttributeTypes: cidrPlanAttrTypes},
+	}
+}
+
+var cidrPlanAttrTypes = map[string]attr.Type{
+	"subnets": types.MapType{ElemType: types.StringType},
+	"free":    types.ListType{ElemType: types.StringType},
+}
+
+func (f *cidrPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var baseCIDR string
+	var requests map[string]string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &baseCIDR, &requests, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	base, err := parseCIDR(baseCIDR)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	opts, err := parseCIDRPlanOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	plan, err := CIDRPlan(base, requests, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, plan))
+}
+
+// CIDRPlanOptions controls CIDRPlan.
+type CIDRPlanOptions struct {
+	// ReservedHosts is added to host counts before they are rounded up to a
+	// subnet size.
+	ReservedHosts int
+	// Previous maps subnet names to the CIDRs of an earlier plan.
+	Previous map[string]string
+}
+
+// DefaultCIDRPlanOptions returns options that reserve the network and
+// broadcast addresses of each subnet.
+func DefaultCIDRPlanOptions() CIDRPlanOptions {
+	return CIDRPlanOptions{ReservedHosts: 2}
+}
+
+func parseCIDRPlanOptions(args []types.Dynamic) (CIDRPlanOptions, error) {
+	opts, err := parseOptions(args, "reserved_hosts", "previous")
+	if err != nil {
+		return CIDRPlanOptions{}, err
+	}
+
+	result := DefaultCIDRPlanOptions()
+	if result.ReservedHosts, err = opts.Int("reserved_hosts", result.ReservedHosts); err != nil {
+		return CIDRPlanOptions{}, err
+	}
+	if result.Previous, err = opts.StringMap("previous"); err != nil {
+		return CIDRPlanOptions{}, err
+	}
+	return result, nil
+}
+
+// CIDRPlanResult is the outcome of CIDRPlan.
+type CIDRPlanResult struct {
+	Subnets map[string]string `tfsdk:"subnets"`
+	Free    []string          `tfsdk:"free"`
+}
+
+// CIDRPlan allocates a subnet of base for every request. Subnets of the
+// previous plan that are still requested with the same prefix length are
+// kept; the rest are placed largest first, ties broken by name, at the
+// lowest address where they fit.
+func CIDRPlan(base netip.Prefix, requests map[string]string, opts CIDRPlanOptions) (CIDRPlanResult, error) {
+	if opts.ReservedHosts < 0 {
+		return CIDRPlanResult{}, fmt.Errorf("reserved_hosts must not be negative, got %d", opts.ReservedHosts)
+	}
+
+	type request struct {
+		name string
+		bits int
+	}
+	pending := make([]request, 0, len(requests))
+	for name, size := range requests {
+		b, err := cidrPlanRequestBits(base, size, opts.ReservedHosts)
+		if err != nil {
+			return CIDRPlanResult{}, fmt.Errorf("subnet %q: %w", name, err)
+		}
+		pending = append(pending, request{name, b})
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if pending[i].bits != pending[j].bits {
+			return pending[i].bits < pending[j].bits
+		}
+		return pending[i].name < pending[j].name
+	})
+
+	subnets := make(map[string]string, len(requests))
+	// kept holds the names of the previous subnets in allocated.
+	var allocated []netip.Prefix
+	var kept []string
+	var remaining []request
+	for _, r := range pending {
+		previous, ok := opts.Previous[r.name]
+		if !ok {
+			remaining = append(remaining, r)
+			continue
+		}
+		p, err := parseCIDR(previous)
+		if err != nil {
+			return CIDRPlanResult{}, fmt.Errorf("previous subnet %q: %w", r.name, err)
+		}
+		if !prefixContains(base, p) {
+			return CIDRPlanResult{}, fmt.Errorf("previous subnet %q (%s) is outside %s", r.name, p, base)
+		}
+		if p.Bits() != r.bits {
+			remaining = append(remaining, r)
+			continue
+		}
+		for i, q := range allocated {
+			if q.Overlaps(p) {
+				return CIDRPlanResult{}, fmt.Errorf("previous subnets %q (%s) and %q (%s) overlap", kept[i], q, r.name, p)
+			}
+		}
+		subnets[r.name] = p.String()
+		allocated = append(allocated, p)
+		kept = append(kept, r.name)
+	}
+
+	for _, r := range remaining {
+		var placed netip.Prefix
+		for _, free := range excludePrefixes(base, allocated) {
+			if free.Bits() <= r.bits {
+				placed = netip.PrefixFrom(free.Addr(), r.bits)
+				break
+			}
+		}
+		if !placed.IsValid() {
+			return CIDRPlanResult{}, fmt.Errorf("not enough space in %s for subnet %q (/%d)", base, r.name, r.bits)
+		}
+		subnets[r.name] = placed.String()
+		allocated = append(allocated, placed)
+	}
+
+	return CIDRPlanResult{
+		Subnets: subnets,
+		Free:    prefixStrings(excludePrefixes(base, allocated)),
+	}, nil
+}
+
+// cidrPlanRequestBits converts a request, "/N" or a host count, into a
+// prefix length that fits in base.
+func cidrPlanRequestBits(base netip.Prefix, size string, reservedHosts int) (int, error) {
+	addrBits := base.Addr().BitLen()
+	var b int
+	if length, ok := strings.CutPrefix(size, "/"); ok {
+		n, err := strconv.Atoi(length)
+		if err != nil || n < 0 || n > addrBits {
+			return 0, fmt.Errorf("invalid prefix length %q, expected /0 to /%d", size, addrBits)
+		}
+		b = n
+	} else {
+		hosts, err := strconv.ParseUint(size, 10, 64)
+		if err != nil || hosts == 0 {
+			return 0, fmt.Errorf("invalid size %q, expected a prefix length such as /24 or a positive number of hosts", size)
+		}
+		needed := hosts + uint64(reservedHosts)
+		if needed < hosts {
+			return 0, fmt.Errorf("%s hosts do not fit in %s", size, base)
+		}
+		// The smallest power of two holding needed addresses is
+		// 2^bits.Len64(needed-1).
+		b = addrBits - bits.Len64(needed-1)
+		if b < 0 {
+			return 0, fmt.Errorf("%s hosts do not fit in %s", size, base)
+		}
+	}
+	if b < base.Bits() {
+		return 0, fmt.Errorf("/%d is larger than %s", b, base)
+	}
+	return b, nil
+}
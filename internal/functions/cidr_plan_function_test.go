@@ -0,0 +1,188 @@
+package functions
+
+import (
+	"context"
+	"maps"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCIDRPlan(t *testing.T) {
+	first := map[string]string{
+		"db":    "10.0.0.0/20",
+		"web":   "10.0.16.0/24",
+		"cache": "10.0.17.0/25",
+	}
+
+	tests := []struct {
+		name     string
+		base     string
+		requests map[string]string
+		opts     CIDRPlanOptions
+		want     map[string]string
+		wantFree []string
+		wantErr  bool
+	}{
+		{
+			name:     "largest first",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"web": "/24", "db": "/20", "cache": "100"},
+			opts:     DefaultCIDRPlanOptions(),
+			want:     first,
+			wantFree: []string{"10.0.17.128/25", "10.0.18.0/23", "10.0.20.0/22", "10.0.24.0/21", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "new subnet repacks without previous",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"web": "/24", "db": "/20", "cache": "100", "api": "/20"},
+			opts:     DefaultCIDRPlanOptions(),
+			want:     map[string]string{"api": "10.0.0.0/20", "db": "10.0.16.0/20", "web": "10.0.32.0/24", "cache": "10.0.33.0/25"},
+			wantFree: []string{"10.0.33.128/25", "10.0.34.0/23", "10.0.36.0/22", "10.0.40.0/21", "10.0.48.0/20", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "new subnet keeps previous",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"web": "/24", "db": "/20", "cache": "100", "api": "/20"},
+			opts:     CIDRPlanOptions{ReservedHosts: 2, Previous: first},
+			want:     map[string]string{"db": "10.0.0.0/20", "web": "10.0.16.0/24", "cache": "10.0.17.0/25", "api": "10.0.32.0/20"},
+			wantFree: []string{"10.0.17.128/25", "10.0.18.0/23", "10.0.20.0/22", "10.0.24.0/21", "10.0.48.0/20", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "resized subnet moves alone",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"web": "/23", "db": "/20", "cache": "100"},
+			opts:     CIDRPlanOptions{ReservedHosts: 2, Previous: first},
+			want:     map[string]string{"db": "10.0.0.0/20", "web": "10.0.18.0/23", "cache": "10.0.17.0/25"},
+			wantFree: []string{"10.0.16.0/24", "10.0.17.128/25", "10.0.20.0/22", "10.0.24.0/21", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "removed subnet is freed and reused",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"db": "/20", "cache": "100", "queue": "/24"},
+			opts:     CIDRPlanOptions{ReservedHosts: 2, Previous: first},
+			want:     map[string]string{"db": "10.0.0.0/20", "cache": "10.0.17.0/25", "queue": "10.0.16.0/24"},
+			wantFree: []string{"10.0.17.128/25", "10.0.18.0/23", "10.0.20.0/22", "10.0.24.0/21", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "host counts",
+			base:     "10.0.0.0/16",
+			requests: map[string]string{"a": "254", "b": "255"},
+			opts:     DefaultCIDRPlanOptions(),
+			want:     map[string]string{"b": "10.0.0.0/23", "a": "10.0.2.0/24"},
+			wantFree: []string{"10.0.3.0/24", "10.0.4.0/22", "10.0.8.0/21", "10.0.16.0/20", "10.0.32.0/19", "10.0.64.0/18", "10.0.128.0/17"},
+		},
+		{
+			name:     "host counts without reserved addresses",
+			base:     "10.0.0.0/23",
+			requests: map[string]string{"a": "256", "b": "1"},
+			opts:     CIDRPlanOptions{},
+			want:     map[string]string{"a": "10.0.0.0/24", "b": "10.0.1.0/32"},
+			wantFree: []string{"10.0.1.1/32", "10.0.1.2/31", "10.0.1.4/30", "10.0.1.8/29", "10.0.1.16/28", "10.0.1.32/27", "10.0.1.64/26", "10.0.1.128/25"},
+		},
+		{
+			name:     "exact fit",
+			base:     "10.0.0.0/24",
+			requests: map[string]string{"a": "/25", "b": "/26", "c": "/26"},
+			opts:     DefaultCIDRPlanOptions(),
+			want:     map[string]string{"a": "10.0.0.0/25", "b": "10.0.0.128/26", "c": "10.0.0.192/26"},
+			wantFree: []string{},
+		},
+		{
+			name:     "ipv6",
+			base:     "2001:db8::/48",
+			requests: map[string]string{"a": "/64", "b": "/56"},
+			opts:     DefaultCIDRPlanOptions(),
+			want:     map[string]string{"b": "2001:db8::/56", "a": "2001:db8:0:100::/64"},
+			wantFree: []string{"2001:db8:0:101::/64", "2001:db8:0:102::/63", "2001:db8:0:104::/62", "2001:db8:0:108::/61", "2001:db8:0:110::/60", "2001:db8:0:120::/59", "2001:db8:0:140::/58", "2001:db8:0:180::/57", "2001:db8:0:200::/55", "2001:db8:0:400::/54", "2001:db8:0:800::/53", "2001:db8:0:1000::/52", "2001:db8:0:2000::/51", "2001:db8:0:4000::/50", "2001:db8:0:8000::/49"},
+		},
+		{name: "no space", base: "10.0.0.0/24", requests: map[string]string{"a": "/25", "b": "/25", "c": "/26"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "prefix too long", base: "10.0.0.0/24", requests: map[string]string{"a": "/33"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "larger than base", base: "10.0.0.0/24", requests: map[string]string{"a": "/23"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "too many hosts", base: "10.0.0.0/24", requests: map[string]string{"a": "300"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "zero hosts", base: "10.0.0.0/24", requests: map[string]string{"a": "0"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "invalid size", base: "10.0.0.0/24", requests: map[string]string{"a": "large"}, opts: DefaultCIDRPlanOptions(), wantErr: true},
+		{name: "negative reserved hosts", base: "10.0.0.0/24", requests: map[string]string{"a": "/25"}, opts: CIDRPlanOptions{ReservedHosts: -1}, wantErr: true},
+		{
+			name:     "previous outside base",
+			base:     "10.0.0.0/24",
+			requests: map[string]string{"a": "/25"},
+			opts:     CIDRPlanOptions{Previous: map[string]string{"a": "10.1.0.0/25"}},
+			wantErr:  true,
+		},
+		{
+			name:     "previous overlap",
+			base:     "10.0.0.0/24",
+			requests: map[string]string{"a": "/25", "b": "/26"},
+			opts:     CIDRPlanOptions{Previous: map[string]string{"a": "10.0.0.0/25", "b": "10.0.0.64/26"}},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CIDRPlan(netip.MustParsePrefix(tt.base), tt.requests, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CIDRPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !maps.Equal(got.Subnets, tt.want) {
+				t.Errorf("CIDRPlan() subnets = %v, want %v", got.Subnets, tt.want)
+			}
+			if !slices.Equal(got.Free, tt.wantFree) {
+				t.Errorf("CIDRPlan() free = %v, want %v", got.Free, tt.wantFree)
+			}
+		})
+	}
+}
+
+func TestCIDRPlanFunction_Run(t *testing.T) {
+	f := NewCIDRPlanFunction()
+	requests := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"public":  types.StringValue("/24"),
+		"private": types.StringValue("/23"),
+	})
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("10.0.0.0/22"),
+			requests,
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewObjectNull(cidrPlanAttrTypes))}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ObjectValueMust(cidrPlanAttrTypes, map[string]attr.Value{
+		"subnets": types.MapValueMust(types.StringType, map[string]attr.Value{
+			"private": types.StringValue("10.0.0.0/23"),
+			"public":  types.StringValue("10.0.2.0/24"),
+		}),
+		"free": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.3.0/24")}),
+	})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("10.0.0.1/22"),
+		requests,
+		types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewObjectNull(cidrPlanAttrTypes))}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Error("expected error for CIDR with host bits set")
+	}
+}
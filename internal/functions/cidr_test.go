@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestParseCIDR(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "10.0.0.0/16", want: "10.0.0.0/16"},
+		{input: "2001:db8::/32", want: "2001:db8::/32"},
+		{input: "10.0.0.1/16", wantErr: true},
+		{input: "10.0.0.0", wantErr: true},
+		{input: "fe80::%eth0/64", wantErr: true},
+		{input: "not-a-cidr", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseCIDR(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCIDR(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("parseCIDR(%q) = %s, want %s", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExcludePrefixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		base     string
+		excluded []string
+		want     []string
+	}{
+		{name: "nothing excluded", base: "10.0.0.0/24", want: []string{"10.0.0.0/24"}},
+		{name: "everything excluded", base: "10.0.0.0/24", excluded: []string{"10.0.0.0/16"}, want: []string{}},
+		{name: "disjoint", base: "10.0.0.0/24", excluded: []string{"10.1.0.0/24"}, want: []string{"10.0.0.0/24"}},
+		{
+			name:     "middle block",
+			base:     "10.0.0.0/24",
+			excluded: []string{"10.0.0.64/26"},
+			want:     []string{"10.0.0.0/26", "10.0.0.128/25"},
+		},
+		{
+			name:     "single address",
+			base:     "192.168.0.0/29",
+			excluded: []string{"192.168.0.5/32"},
+			want:     []string{"192.168.0.0/30", "192.168.0.4/32", "192.168.0.6/31"},
+		},
+		{
+			name:     "ipv6",
+			base:     "2001:db8::/62",
+			excluded: []string{"2001:db8:0:1::/64"},
+			want:     []string{"2001:db8::/64", "2001:db8:0:2::/63"},
+		},
+		{name: "other family ignored", base: "10.0.0.0/8", excluded: []string{"::/0"}, want: []string{"10.0.0.0/8"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var excluded []netip.Prefix
+			for _, e := range tt.excluded {
+				excluded = append(excluded, netip.MustParsePrefix(e))
+			}
+			got := prefixStrings(excludePrefixes(netip.MustParsePrefix(tt.base), excluded))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("excludePrefixes(%s, %v) = %v, want %v", tt.base, tt.excluded, got, tt.want)
+			}
+		})
+	}
+}
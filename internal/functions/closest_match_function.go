@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*closestMatchFunction)(nil)
+
+type closestMatchFunction struct{}
+
+func NewClosestMatchFunction() function.Function {
+	return &closestMatchFunction{}
+}
+
+func (f *closestMatchFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "closest_match"
+}
+
+func (f *closestMatchFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Finds the candidate closest to a string, for \"did you mean\" suggestions",
+		Description: "Candidates are compared case-insensitively by Damerau-Levenshtein distance, so a swapped pair of letters counts as one edit. " +
+			"Ties go to the candidate listed first. Returns null when no candidate is within max_distance.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to find a match for",
+			},
+			function.ListParameter{
+				ElementType: types.StringType,
+				Name:        "candidates",
+				Description: "The strings to choose from",
+			},
+			function.Int64Parameter{
+				Name:        "max_distance",
+				Description: "The largest number of edits a match may be away from input",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *closestMatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var candidates []string
+	var maxDistance int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &candidates, &maxDistance))
+	if resp.Error != nil {
+		return
+	}
+
+	if maxDistance < 0 {
+		resp.Error = function.NewArgumentFuncError(2, "max_distance must not be negative")
+		return
+	}
+
+	match, ok := ClosestMatch(input, candidates, int(maxDistance))
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringNull()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, match))
+}
@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestClosestMatch(t *testing.T) {
+	regions := []string{"us-east-1", "us-east-2", "us-west-2", "eu-west-1"}
+
+	tests := []struct {
+		name        string
+		input       string
+		candidates  []string
+		maxDistance int
+		want        string
+		wantOK      bool
+	}{
+		{name: "exact", input: "us-west-2", candidates: regions, maxDistance: 0, want: "us-west-2", wantOK: true},
+		{name: "transposed letters", input: "us-esat-1", candidates: regions, maxDistance: 1, want: "us-east-1", wantOK: true},
+		{name: "case-insensitive", input: "EU-WEST-1", candidates: regions, maxDistance: 0, want: "eu-west-1", wantOK: true},
+		{name: "tie goes to first candidate", input: "us-east-3", candidates: regions, maxDistance: 2, want: "us-east-1", wantOK: true},
+		{name: "closest wins over earlier", input: "us-wes-2", candidates: regions, maxDistance: 3, want: "us-west-2", wantOK: true},
+		{name: "too far", input: "ap-south-1", candidates: regions, maxDistance: 2},
+		{name: "no candidates", input: "x", maxDistance: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ClosestMatch(tt.input, tt.candidates, tt.maxDistance)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ClosestMatch(%q) = %q, %v, want %q, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestClosestMatchFunction_Run(t *testing.T) {
+	f := NewClosestMatchFunction()
+	candidates := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("production"),
+		types.StringValue("staging"),
+	})
+
+	tests := []struct {
+		name        string
+		input       string
+		maxDistance int64
+		want        basetypes.StringValue
+		wantErr     bool
+	}{
+		{name: "match", input: "prodution", maxDistance: 2, want: types.StringValue("production")},
+		{name: "no match is null", input: "development", maxDistance: 2, want: types.StringNull()},
+		{name: "negative max_distance", input: "x", maxDistance: -1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.input),
+					candidates,
+					types.Int64Value(tt.maxDistance),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value(); !got.Equal(tt.want) {
+				t.Errorf("Run(%q) result = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
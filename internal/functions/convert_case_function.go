@@ -0,0 +1,139 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*convertCaseFunction)(nil)
+
+type convertCaseFunction struct{}
+
+func NewConvertCaseFunction() function.Function {
+	return &convertCaseFunction{}
+}
+
+func (f *convertCaseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_case"
+}
+
+func (f *convertCaseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a string to snake, kebab, camel, pascal, constant or title case",
+		Description: "Words are split at non-alphanumeric characters, at lower-to-upper case changes, and before the last capital of an acronym, so HTTPServer becomes http_server. " +
+			"Digits stay with the word they follow, so IPv4Address becomes ipv4_address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+			function.StringParameter{
+				Name:        "style",
+				Description: "The target case: snake, kebab, camel, pascal, constant or title",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *convertCaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, style string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &style))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ConvertCase(input, style)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ConvertCase rewrites s in the given style: "snake" (http_server),
+// "kebab" (http-server), "camel" (httpServer), "pascal" (HttpServer),
+// "constant" (HTTP_SERVER) or "title" (Http Server).
+func ConvertCase(s, style string) (string, error) {
+	words := splitWords(s)
+	switch style {
+	case "snake":
+		return strings.ToLower(strings.Join(words, "_")), nil
+	case "kebab":
+		return strings.ToLower(strings.Join(words, "-")), nil
+	case "constant":
+		return strings.ToUpper(strings.Join(words, "_")), nil
+	case "camel":
+		for i, w := range words {
+			if i == 0 {
+				words[i] = strings.ToLower(w)
+			} else {
+				words[i] = capitalize(w)
+			}
+		}
+		return strings.Join(words, ""), nil
+	case "pascal":
+		for i, w := range words {
+			words[i] = capitalize(w)
+		}
+		return strings.Join(words, ""), nil
+	case "title":
+		for i, w := range words {
+			words[i] = capitalize(w)
+		}
+		return strings.Join(words, " "), nil
+	default:
+		return "", fmt.Errorf("unsupported style %q, expected one of: snake, kebab, camel, pascal, constant, title", style)
+	}
+}
+
+// splitWords breaks s into words. Any character that is not a letter or
+// digit separates words; within a run of letters and digits a new word
+// starts at an upper-case letter that follows a lower-case letter or digit,
+// or that ends a run of capitals and is followed by lower-case letters.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			continue
+		}
+		if unicode.IsUpper(r) {
+			prev := runes[i-1]
+			// A single lower-case letter after capitals is kept with the
+			// acronym, as in IPv4 and URLs.
+			nextLower := i+2 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsLower(runes[i+2])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+	}
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// capitalize upper-cases the first letter of w and lower-cases the rest.
+func capitalize(w string) string {
+	runes := []rune(strings.ToLower(w))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToTitle(runes[0])
+	}
+	return string(runes)
+}
@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestConvertCase(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		style   string
+		want    string
+		wantErr bool
+	}{
+		{name: "acronym prefix", input: "HTTPServer", style: "snake", want: "http_server"},
+		{name: "acronym suffix", input: "parseJSON", style: "snake", want: "parse_json"},
+		{name: "acronym in middle", input: "getHTTPResponseCode", style: "snake", want: "get_http_response_code"},
+		{name: "digits stay with word", input: "IPv4Address", style: "snake", want: "ipv4_address"},
+		{name: "plural acronym", input: "listURLs", style: "snake", want: "list_urls"},
+		{name: "digits after acronym", input: "HTTP2Server", style: "snake", want: "http2_server"},
+		{name: "digits in lower word", input: "ec2Instance", style: "kebab", want: "ec2-instance"},
+		{name: "mixed separators", input: "  foo_bar-baz qux ", style: "camel", want: "fooBarBazQux"},
+		{name: "unicode letters", input: "ÄrgerÜberÖl", style: "snake", want: "ärger_über_öl"},
+		{name: "greek", input: "καλημέραΚόσμε", style: "pascal", want: "ΚαλημέραΚόσμε"},
+		{name: "kebab", input: "myBucketName", style: "kebab", want: "my-bucket-name"},
+		{name: "camel", input: "my_bucket_name", style: "camel", want: "myBucketName"},
+		{name: "camel from acronym", input: "HTTPServer", style: "camel", want: "httpServer"},
+		{name: "pascal", input: "my-bucket-name", style: "pascal", want: "MyBucketName"},
+		{name: "constant", input: "myBucketName", style: "constant", want: "MY_BUCKET_NAME"},
+		{name: "title", input: "my_bucket_name", style: "title", want: "My Bucket Name"},
+		{name: "empty", input: "", style: "snake", want: ""},
+		{name: "unsupported style", input: "x", style: "upper", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertCase(tt.input, tt.style)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertCase() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertCaseFunction_Run(t *testing.T) {
+	f := NewConvertCaseFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("HTTPServer"),
+			types.StringValue("kebab"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "http-server")
+}
@@ -0,0 +1,124 @@
+package functions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*convertKeysCaseFunction)(nil)
+
+type convertKeysCaseFunction struct{}
+
+func NewConvertKeysCaseFunction() function.Function {
+	return &convertKeysCaseFunction{}
+}
+
+func (f *convertKeysCaseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "convert_keys_case"
+}
+
+func (f *convertKeysCaseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Recursively converts the object keys of a JSON document to another case",
+		Description: "Keys are converted as convert_case does, including keys of objects nested in arrays. Values are left unchanged. It is an error for two keys of the same object to convert to the same name.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "document",
+				Description: "The JSON document, e.g. the output of deep_merge",
+			},
+			function.StringParameter{
+				Name:        "style",
+				Description: "The target case: snake, kebab, camel, pascal, constant or title",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *convertKeysCaseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document, style string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document, &style))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ConvertKeysCase(document, style)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ConvertKeysCase rewrites every object key in the JSON document with
+// ConvertCase. Numbers are preserved exactly as written.
+func ConvertKeysCase(document, style string) (string, error) {
+	if _, err := ConvertCase("", style); err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader([]byte(document)))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("invalid JSON: %w", err)
+	}
+	if dec.More() {
+		return "", fmt.Errorf("invalid JSON: unexpected data after top-level value")
+	}
+
+	converted, err := convertKeys(v, style)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.Marshal(converted)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode result: %w", err)
+	}
+	return string(out), nil
+}
+
+func convertKeys(v any, style string) (any, error) {
+	switch val := v.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(val))
+		sources := make(map[string]string, len(val))
+		// Walk the keys in order so that a collision is always reported
+		// the same way.
+		for _, k := range slices.Sorted(maps.Keys(val)) {
+			key, err := ConvertCase(k, style)
+			if err != nil {
+				return nil, err
+			}
+			if other, exists := sources[key]; exists {
+				return nil, fmt.Errorf("keys %q and %q both convert to %q", other, k, key)
+			}
+			sources[key] = k
+
+			result[key], err = convertKeys(val[k], style)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	case []any:
+		result := make([]any, len(val))
+		for i, child := range val {
+			var err error
+			if result[i], err = convertKeys(child, style); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	default:
+		return v, nil
+	}
+}
@@ -0,0 +1,93 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestConvertKeysCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		style    string
+		want     string
+		wantErr  string
+	}{
+		{
+			name:     "nested objects",
+			document: `{"bucketName":"logs","serverConfig":{"maxConnections":10}}`,
+			style:    "snake",
+			want:     `{"bucket_name":"logs","server_config":{"max_connections":10}}`,
+		},
+		{
+			name:     "objects in arrays",
+			document: `{"rules":[{"ruleName":"a"},{"ruleName":"b"}]}`,
+			style:    "kebab",
+			want:     `{"rules":[{"rule-name":"a"},{"rule-name":"b"}]}`,
+		},
+		{
+			name:     "values untouched",
+			document: `{"my_key":"some_value"}`,
+			style:    "camel",
+			want:     `{"myKey":"some_value"}`,
+		},
+		{
+			name:     "large numbers preserved",
+			document: `{"big_number":12345678901234567890}`,
+			style:    "pascal",
+			want:     `{"BigNumber":12345678901234567890}`,
+		},
+		{name: "scalar document", document: `"HTTPServer"`, style: "snake", want: `"HTTPServer"`},
+		{name: "key collision", document: `{"myKey":1,"my_key":2}`, style: "snake", wantErr: `keys "myKey" and "my_key" both convert to "my_key"`},
+		{name: "invalid json", document: `{`, style: "snake", wantErr: "invalid JSON: unexpected EOF"},
+		{name: "trailing data", document: `{} {}`, style: "snake", wantErr: "invalid JSON: unexpected data after top-level value"},
+		{name: "unsupported style", document: `{}`, style: "upper", wantErr: `unsupported style "upper", expected one of: snake, kebab, camel, pascal, constant, title`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertKeysCase(tt.document, tt.style)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("ConvertKeysCase() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConvertKeysCase() unexpected error: %v", err)
+			}
+			assertEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestConvertKeysCaseFunction_Run(t *testing.T) {
+	f := NewConvertKeysCaseFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(`{"tag_set":{"cost_center":"42"}}`),
+			types.StringValue("pascal"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), `{"TagSet":{"CostCenter":"42"}}`)
+}
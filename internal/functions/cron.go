@@ -0,0 +1,607 @@
+package functions
+
+import (
+	"fmt"
+	"math/bits"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron dialects accepted by ValidateCron and CronNext.
+const (
+	cronStandard = "standard"
+	cronQuartz   = "quartz"
+	cronAWS      = "aws"
+)
+
+// cronField describes one field of a cron expression.
+type cronField struct {
+	Name     string
+	Min, Max int
+	// Names maps three-letter names, such as JAN or MON, to values.
+	Names map[string]int
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	// cronWeekdayNames numbers days from 0 as in standard cron; the Quartz
+	// and AWS dialects add one.
+	cronWeekdayNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+)
+
+func cronWeekdayNamesFrom(first int) map[string]int {
+	names := make(map[string]int, len(cronWeekdayNames))
+	for name, v := range cronWeekdayNames {
+		names[name] = v + first
+	}
+	return names
+}
+
+var (
+	cronSecond     = cronField{Name: "second", Min: 0, Max: 59}
+	cronMinute     = cronField{Name: "minute", Min: 0, Max: 59}
+	cronHour       = cronField{Name: "hour", Min: 0, Max: 23}
+	cronDayOfMonth = cronField{Name: "day-of-month", Min: 1, Max: 31}
+	cronMonth      = cronField{Name: "month", Min: 1, Max: 12, Names: cronMonthNames}
+)
+
+// cronDialect describes the fields of a cron dialect. Year is the optional
+// or required last field, if the dialect has one.
+type cronDialect struct {
+	Fields []cronField
+	Year   *cronField
+	// YearRequired is set when the year field must be present.
+	YearRequired bool
+	// Extended dialects require "?" in one of the day fields and support
+	// L, W and # in them.
+	Extended bool
+	// FirstWeekday is the number of Sunday in the day-of-week field.
+	FirstWeekday int
+	// Macros maps shorthands such as @daily to expressions.
+	Macros map[string]string
+}
+
+var cronDialects = map[string]cronDialect{
+	cronStandard: {
+		Fields: []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth,
+			// 7 is accepted for Sunday as well as 0.
+			{Name: "day-of-week", Min: 0, Max: 7, Names: cronWeekdayNames}},
+		Macros: map[string]string{
+			"@yearly":   "0 0 1 1 *",
+			"@annually": "0 0 1 1 *",
+			"@monthly":  "0 0 1 * *",
+			"@weekly":   "0 0 * * 0",
+			"@daily":    "0 0 * * *",
+			"@midnight": "0 0 * * *",
+			"@hourly":   "0 * * * *",
+		},
+	},
+	cronQuartz: {
+		Fields: []cronField{cronSecond, cronMinute, cronHour, cronDayOfMonth, cronMonth,
+			{Name: "day-of-week", Min: 1, Max: 7, Names: cronWeekdayNamesFrom(1)}},
+		Year:         &cronField{Name: "year", Min: 1970, Max: 2099},
+		Extended:     true,
+		FirstWeekday: 1,
+	},
+	cronAWS: {
+		Fields: []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth,
+			{Name: "day-of-week", Min: 1, Max: 7, Names: cronWeekdayNamesFrom(1)}},
+		Year:         &cronField{Name: "year", Min: 1970, Max: 2199},
+		YearRequired: true,
+		Extended:     true,
+		FirstWeekday: 1,
+	},
+}
+
+func cronDialectNames() []string {
+	names := make([]string, 0, len(cronDialects))
+	for name := range cronDialects {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// cronSchedule is a parsed cron expression. Sets of values are bitmasks
+// with bit i set when value i matches.
+type cronSchedule struct {
+	second, minute, hour, month uint64
+	// years is indexed from yearBase; nil matches every year.
+	years    []bool
+	yearBase int
+
+	dom, dow cronDaySpec
+	// domOrDow is set when a day matching either day field is enough.
+	domOrDow bool
+	// wildcardTime is set when the minute or hour field starts with "*".
+	// Such schedules also run in the second pass of a repeated hour.
+	wildcardTime bool
+}
+
+// cronDaySpec holds a day-of-month or day-of-week field, including the L, W
+// and # forms of the extended dialects.
+type cronDaySpec struct {
+	// days are days of the month, or weekdays with Sunday as 0.
+	days uint64
+	// lastOffsets are day-of-month offsets from the last day: L is 0, L-3 is 3.
+	lastOffsets []int
+	// nearestWeekday are days of the month written nW.
+	nearestWeekday []int
+	// lastWeekday is set by LW.
+	lastWeekday bool
+	// nth are weekday#n pairs, lastOf the weekdays written nL.
+	nth    [][2]int
+	lastOf []int
+}
+
+// ValidateCron returns the reasons expr is not a valid cron expression in
+// dialect, or nil if it is valid. An unknown dialect is an error.
+func ValidateCron(expr, dialect string) ([]string, error) {
+	if _, ok := cronDialects[dialect]; !ok {
+		return nil, fmt.Errorf("unsupported dialect %q, expected one of: %s", dialect, strings.Join(cronDialectNames(), ", "))
+	}
+	_, problems := parseCron(expr, dialect)
+	return problems, nil
+}
+
+// guessCronDialect picks the dialect of expr from its shape: the cron(...)
+// form is aws, five fields or a macro are standard, and otherwise quartz.
+func guessCronDialect(expr string) string {
+	expr = strings.TrimSpace(expr)
+	switch {
+	case strings.HasPrefix(expr, "cron("):
+		return cronAWS
+	case strings.HasPrefix(expr, "@"), len(strings.Fields(expr)) == 5:
+		return cronStandard
+	default:
+		return cronQuartz
+	}
+}
+
+func parseCron(expr, dialectName string) (*cronSchedule, []string) {
+	dialect := cronDialects[dialectName]
+	expr = strings.TrimSpace(expr)
+	if dialectName == cronAWS {
+		if inner, ok := strings.CutPrefix(expr, "cron("); ok {
+			if expr, ok = strings.CutSuffix(inner, ")"); !ok {
+				return nil, []string{"expression starts with \"cron(\" but does not end with \")\""}
+			}
+		}
+	}
+	if strings.HasPrefix(expr, "@") {
+		macro, ok := dialect.Macros[strings.ToLower(expr)]
+		if !ok {
+			if len(dialect.Macros) == 0 {
+				return nil, []string{fmt.Sprintf("%s does not support macros such as %s", dialectName, expr)}
+			}
+			macros := make([]string, 0, len(dialect.Macros))
+			for name := range dialect.Macros {
+				macros = append(macros, name)
+			}
+			slices.Sort(macros)
+			return nil, []string{fmt.Sprintf("unknown macro %s, expected one of: %s", expr, strings.Join(macros, ", "))}
+		}
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	allFields := slices.Clone(dialect.Fields)
+	if dialect.Year != nil {
+		allFields = append(allFields, *dialect.Year)
+	}
+	minFields := len(allFields)
+	if dialect.Year != nil && !dialect.YearRequired {
+		minFields--
+	}
+	if len(fields) < minFields || len(fields) > len(allFields) {
+		names := make([]string, len(allFields))
+		for i, f := range allFields {
+			names[i] = f.Name
+		}
+		want := strconv.Itoa(minFields)
+		if minFields != len(allFields) {
+			want = fmt.Sprintf("%d or %d", minFields, len(allFields))
+		}
+		return nil, []string{fmt.Sprintf("expected %s fields (%s), got %d", want, strings.Join(names, " "), len(fields))}
+	}
+
+	s := &cronSchedule{second: 1}
+	var domStar, dowStar bool
+	var problems []string
+	dayFields := len(dialect.Fields) - 3 // index of day-of-month
+	for i, text := range fields {
+		field := allFields[i]
+		var err error
+		switch {
+		case i == dayFields:
+			domStar, err = parseCronDayField(text, field, dialect, false, &s.dom)
+		case i == dayFields+2:
+			dowStar, err = parseCronDayField(text, field, dialect, true, &s.dow)
+		case field.Name == "year":
+			s.years, s.yearBase, err = parseCronYears(text, field)
+		default:
+			var set uint64
+			set, err = parseCronSet(text, field)
+			switch field.Name {
+			case "second":
+				s.second = set
+			case "minute":
+				s.minute = set
+			case "hour":
+				s.hour = set
+			case "month":
+				s.month = set
+			}
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s field %q: %s", field.Name, text, err))
+		}
+	}
+	if problems != nil {
+		return nil, problems
+	}
+	s.wildcardTime = strings.HasPrefix(fields[dayFields-2], "*") || strings.HasPrefix(fields[dayFields-1], "*")
+
+	if dialect.Extended {
+		domAny, dowAny := fields[dayFields] == "?", fields[dayFields+2] == "?"
+		switch {
+		case domAny && dowAny:
+			return nil, []string{"day-of-month and day-of-week cannot both be ?"}
+		case !domAny && !dowAny:
+			return nil, []string{"one of day-of-month and day-of-week must be ?, since they cannot both be restricted"}
+		}
+	} else {
+		// Standard cron runs on days matching either field when both are
+		// restricted.
+		s.domOrDow = !domStar && !dowStar
+	}
+	return s, nil
+}
+
+// parseCronSet parses a plain field of comma-separated values, ranges and
+// steps such as "*/15", "1-5", "MON-FRI" or "0,30".
+func parseCronSet(text string, field cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(text, ",") {
+		lo, hi, step, err := parseCronRange(item, field)
+		if err != nil {
+			return 0, err
+		}
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+// parseCronRange parses "*", "v", "a-b", optionally followed by "/step". A
+// single value with a step, such as "5/15", runs from that value to the end
+// of the field.
+func parseCronRange(item string, field cronField) (lo, hi, step int, err error) {
+	base, stepText, hasStep := strings.Cut(item, "/")
+	step = 1
+	if hasStep {
+		if step, err = strconv.Atoi(stepText); err != nil || step < 1 {
+			return 0, 0, 0, fmt.Errorf("step %q must be a positive number", stepText)
+		}
+	}
+
+	switch {
+	case base == "*":
+		return field.Min, field.Max, step, nil
+	case base == "":
+		return 0, 0, 0, fmt.Errorf("empty value in %q", item)
+	}
+	from, to, isRange := strings.Cut(base, "-")
+	if lo, err = parseCronValue(from, field); err != nil {
+		return 0, 0, 0, err
+	}
+	switch {
+	case isRange:
+		if hi, err = parseCronValue(to, field); err != nil {
+			return 0, 0, 0, err
+		}
+		if hi < lo {
+			return 0, 0, 0, fmt.Errorf("range %s goes backwards", base)
+		}
+	case hasStep:
+		hi = field.Max
+	default:
+		hi = lo
+	}
+	return lo, hi, step, nil
+}
+
+func parseCronValue(text string, field cronField) (int, error) {
+	if v, ok := field.Names[strings.ToUpper(text)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		if field.Names != nil {
+			return 0, fmt.Errorf("%q is not a number or a %s name", text, field.Name)
+		}
+		return 0, fmt.Errorf("%q is not a number", text)
+	}
+	if v < field.Min || v > field.Max {
+		return 0, fmt.Errorf("%d is out of range %d-%d", v, field.Min, field.Max)
+	}
+	return v, nil
+}
+
+// parseCronDayField parses a day-of-month or day-of-week field into spec
+// and reports whether it matches every day.
+func parseCronDayField(text string, field cronField, dialect cronDialect, weekday bool, spec *cronDaySpec) (star bool, err error) {
+	if text == "?" && !dialect.Extended {
+		return false, fmt.Errorf("? is not supported in this dialect, use *")
+	}
+	// As in Vixie cron, a field starting with * or ? counts as unrestricted
+	// when deciding how the two day fields combine, even with a step.
+	star = strings.HasPrefix(text, "*") || text == "?"
+
+	for _, item := range strings.Split(text, ",") {
+		switch {
+		case item == "*" || item == "?":
+			if len(text) > 1 {
+				return false, fmt.Errorf("%s cannot be combined with other values", item)
+			}
+			for v := field.Min; v <= field.Max; v++ {
+				spec.add(v, weekday, dialect.FirstWeekday)
+			}
+		case isCronSpecial(item, weekday):
+			if !dialect.Extended {
+				return false, fmt.Errorf("%s uses L, W or #, which are not supported in this dialect", item)
+			}
+			if err := spec.parseSpecial(item, field, weekday, dialect.FirstWeekday); err != nil {
+				return false, err
+			}
+		default:
+			lo, hi, step, err := parseCronRange(item, field)
+			if err != nil {
+				return false, err
+			}
+			for v := lo; v <= hi; v += step {
+				spec.add(v, weekday, dialect.FirstWeekday)
+			}
+		}
+	}
+	return star, nil
+}
+
+// isCronSpecial reports whether item uses the L, W or # forms. Weekday
+// names never end in L, and day-of-month values have no names, so names such
+// as WED are not mistaken for them.
+func isCronSpecial(item string, weekday bool) bool {
+	upper := strings.ToUpper(item)
+	if weekday {
+		return strings.Contains(upper, "#") || strings.HasSuffix(upper, "L")
+	}
+	return strings.HasPrefix(upper, "L") || strings.HasSuffix(upper, "W")
+}
+
+func (spec *cronDaySpec) add(v int, weekday bool, firstWeekday int) {
+	if weekday {
+		v = (v - firstWeekday) % 7
+	}
+	spec.days |= 1 << v
+}
+
+// parseSpecial parses the L, W and # forms: L, L-n, nW and LW in the
+// day-of-month field, and L, nL and n#k in the day-of-week field.
+func (spec *cronDaySpec) parseSpecial(item string, field cronField, weekday bool, firstWeekday int) error {
+	upper := strings.ToUpper(item)
+	if weekday {
+		if day, n, ok := strings.Cut(upper, "#"); ok {
+			d, err := parseCronValue(day, field)
+			if err != nil {
+				return err
+			}
+			k, err := strconv.Atoi(n)
+			if err != nil || k < 1 || k > 5 {
+				return fmt.Errorf("%s: the occurrence after # must be 1 to 5", item)
+			}
+			spec.nth = append(spec.nth, [2]int{(d - firstWeekday) % 7, k})
+			return nil
+		}
+		if upper == "L" {
+			// On its own, L in the day-of-week field is the last day of
+			// the week, Saturday.
+			spec.days |= 1 << 6
+			return nil
+		}
+		if day, ok := strings.CutSuffix(upper, "L"); ok {
+			d, err := parseCronValue(day, field)
+			if err != nil {
+				return err
+			}
+			spec.lastOf = append(spec.lastOf, (d-firstWeekday)%7)
+			return nil
+		}
+		return fmt.Errorf("%s: expected a weekday, nL for the last such weekday of the month, or n#k for the k-th", item)
+	}
+
+	switch {
+	case upper == "L":
+		spec.lastOffsets = append(spec.lastOffsets, 0)
+	case upper == "LW":
+		spec.lastWeekday = true
+	case strings.HasPrefix(upper, "L-"):
+		n, err := strconv.Atoi(upper[2:])
+		if err != nil || n < 0 || n > 30 {
+			return fmt.Errorf("%s: the offset after L- must be 0 to 30", item)
+		}
+		spec.lastOffsets = append(spec.lastOffsets, n)
+	case strings.HasSuffix(upper, "W"):
+		d, err := parseCronValue(strings.TrimSuffix(upper, "W"), field)
+		if err != nil {
+			return err
+		}
+		spec.nearestWeekday = append(spec.nearestWeekday, d)
+	default:
+		return fmt.Errorf("%s: expected a day, L, L-n, nW or LW", item)
+	}
+	return nil
+}
+
+func parseCronYears(text string, field cronField) ([]bool, int, error) {
+	years := make([]bool, field.Max-field.Min+1)
+	for _, item := range strings.Split(text, ",") {
+		lo, hi, step, err := parseCronRange(item, field)
+		if err != nil {
+			return nil, 0, err
+		}
+		for v := lo; v <= hi; v += step {
+			years[v-field.Min] = true
+		}
+	}
+	if text == "*" {
+		return nil, 0, nil
+	}
+	return years, field.Min, nil
+}
+
+// matchesDay reports whether the schedule runs on the day of t.
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	dom, dow := s.dom.matchesMonthDay(t), s.dow.matchesWeekday(t)
+	if s.domOrDow {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func (spec *cronDaySpec) matchesMonthDay(t time.Time) bool {
+	day, last := t.Day(), daysInMonth(t)
+	if spec.days&(1<<day) != 0 {
+		return true
+	}
+	for _, offset := range spec.lastOffsets {
+		if day == last-offset {
+			return true
+		}
+	}
+	for _, n := range spec.nearestWeekday {
+		if n <= last && day == nearestWeekday(t, n, last) {
+			return true
+		}
+	}
+	return spec.lastWeekday && day == nearestWeekday(t, last, last)
+}
+
+// nearestWeekday returns the weekday closest to day n of t's month without
+// leaving the month, as the W suffix requires.
+func nearestWeekday(t time.Time, n, last int) int {
+	switch time.Date(t.Year(), t.Month(), n, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if n == 1 {
+			return 3
+		}
+		return n - 1
+	case time.Sunday:
+		if n == last {
+			return n - 2
+		}
+		return n + 1
+	}
+	return n
+}
+
+func (spec *cronDaySpec) matchesWeekday(t time.Time) bool {
+	weekday, day := int(t.Weekday()), t.Day()
+	if spec.days&(1<<weekday) != 0 {
+		return true
+	}
+	for _, nth := range spec.nth {
+		if weekday == nth[0] && (day-1)/7+1 == nth[1] {
+			return true
+		}
+	}
+	for _, w := range spec.lastOf {
+		if weekday == w && day+7 > daysInMonth(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *cronSchedule) matchesYear(year int) bool {
+	if s.years == nil {
+		return true
+	}
+	i := year - s.yearBase
+	return i >= 0 && i < len(s.years) && s.years[i]
+}
+
+// cronSearchYears bounds the search for the next run time of schedules
+// without a year field. The rarest day, February 29th, can be eight years
+// apart, as from 2096 to 2104.
+const cronSearchYears = 8
+
+// next returns the first run time after t, or false if there is none. Wall
+// clock fields are compared in t's location. As in Vixie cron, hours skipped
+// by a daylight saving time change have no run times, and a repeated hour
+// runs again only for schedules whose minute or hour field starts with "*",
+// so that "30 1 * * *" runs once but "*/15 * * * *" keeps its interval.
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = t.Truncate(time.Second).Add(time.Second)
+	limit := t.Year() + cronSearchYears
+	if s.years != nil {
+		limit = s.yearBase + len(s.years) - 1
+	}
+
+	for t.Year() <= limit {
+		if !s.matchesYear(t.Year()) {
+			t = time.Date(t.Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.month&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		// Within a day, step in absolute time so that daylight saving
+		// time changes neither loop nor skip real hours.
+		if s.hour&(1<<t.Hour()) == 0 {
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second)
+			continue
+		}
+		if s.minute&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute - time.Duration(t.Second())*time.Second)
+			continue
+		}
+		if s.second&(1<<t.Second()) == 0 {
+			// Skip straight to the next matching second of this minute.
+			if rest := s.second >> t.Second(); rest != 0 {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)) * time.Second)
+			} else {
+				t = t.Add(time.Duration(60-t.Second()) * time.Second)
+			}
+			continue
+		}
+		if !s.wildcardTime && repeatedWallClock(t) {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// repeatedWallClock reports whether the wall clock time of t has already
+// occurred once, because clocks went back.
+func repeatedWallClock(t time.Time) bool {
+	first := wallClock(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	return first.Before(t)
+}
@@ -0,0 +1,135 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cronNextFunction)(nil)
+
+type cronNextFunction struct{}
+
+func NewCronNextFunction() function.Function {
+	return &cronNextFunction{}
+}
+
+func (f *cronNextFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next"
+}
+
+func (f *cronNextFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Lists the next run times of a cron expression",
+		Description: "Returns up to count RFC 3339 timestamps strictly after from_timestamp, in the given time zone. " +
+			"Fewer are returned when the schedule ends, such as when a year field runs out. " +
+			"The expression is read in the time zone, an IANA name such as Europe/Berlin, or UTC if empty; the time zone database is built in, so this works on hosts without one. " +
+			"As in cron daemons such as cronie, run times in an hour skipped when daylight saving time starts do not happen, and those in an hour repeated when it ends happen once, " +
+			"unless the minute or hour field starts with *, so that 30 1 * * * runs once but */15 * * * * keeps running every 15 minutes. " +
+			"Options: dialect is standard, quartz or aws, as for cron_validate. By default cron(...) expressions are aws, five fields or a macro are standard, and other expressions are quartz.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "The cron expression",
+			},
+			function.StringParameter{
+				Name:        "from_timestamp",
+				Description: "The RFC 3339 timestamp to start after, such as the result of timestamp()",
+			},
+			function.Int64Parameter{
+				Name:        "count",
+				Description: "The number of run times to return, from 1 to " + fmt.Sprint(cronNextMaxCount),
+			},
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "The IANA time zone the schedule runs in, or empty for UTC",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with a dialect attribute",
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *cronNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, fromTimestamp, timezone string
+	var count int64
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &fromTimestamp, &count, &timezone, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	from, err := parseTimestamp(fromTimestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	if count < 1 || count > cronNextMaxCount {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("count must be between 1 and %d, got %d", cronNextMaxCount, count))
+		return
+	}
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+
+	opts, err := parseOptions(optionArgs, "dialect")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, err.Error())
+		return
+	}
+	dialect, err := opts.String("dialect", "")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, err.Error())
+		return
+	}
+
+	times, err := CronNext(expression, dialect, from.In(loc), int(count))
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result := make([]string, len(times))
+	for i, t := range times {
+		result[i] = t.Format(time.RFC3339)
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// cronNextMaxCount bounds the number of run times cron_next returns.
+const cronNextMaxCount = 1000
+
+// CronNext returns up to count run times of expr after from, matching the
+// expression against wall clock time in from's location. An empty dialect is
+// guessed from the shape of expr.
+func CronNext(expr, dialect string, from time.Time, count int) ([]time.Time, error) {
+	if dialect == "" {
+		dialect = guessCronDialect(expr)
+	}
+	if _, ok := cronDialects[dialect]; !ok {
+		return nil, fmt.Errorf("unsupported dialect %q, expected one of: %s", dialect, strings.Join(cronDialectNames(), ", "))
+	}
+	schedule, problems := parseCron(expr, dialect)
+	if problems != nil {
+		return nil, fmt.Errorf("invalid %s cron expression %q: %s", dialect, expr, strings.Join(problems, "; "))
+	}
+
+	times := make([]time.Time, 0, count)
+	for t := from; len(times) < count; {
+		var ok bool
+		if t, ok = schedule.next(t); !ok {
+			break
+		}
+		times = append(times, t)
+	}
+	return times, nil
+}
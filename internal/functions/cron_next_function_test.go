@@ -0,0 +1,107 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCronNextFunction_Run(t *testing.T) {
+	f := NewCronNextFunction()
+	noOptions := types.TupleValueMust([]attr.Type{}, []attr.Value{})
+	dialect := func(name string) attr.Value {
+		return types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+			types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"dialect": types.StringType},
+				map[string]attr.Value{"dialect": types.StringValue(name)},
+			)),
+		})
+	}
+
+	tests := []struct {
+		name     string
+		expr     string
+		from     string
+		count    int64
+		timezone string
+		options  attr.Value
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:    "utc",
+			expr:    "0 3 * * *",
+			from:    "2026-10-19T12:00:00Z",
+			count:   2,
+			options: noOptions,
+			want:    []string{"2026-10-20T03:00:00Z", "2026-10-21T03:00:00Z"},
+		},
+		{
+			name:     "time zone",
+			expr:     "0 3 * * *",
+			from:     "2026-10-19T12:00:00Z",
+			count:    1,
+			timezone: "Europe/Berlin",
+			options:  noOptions,
+			want:     []string{"2026-10-20T03:00:00+02:00"},
+		},
+		{
+			name:    "explicit dialect",
+			expr:    "0 0 12 ? * MON-FRI",
+			from:    "2026-10-17T00:00:00Z",
+			count:   1,
+			options: dialect("quartz"),
+			want:    []string{"2026-10-19T12:00:00Z"},
+		},
+		{
+			name:    "schedule ends",
+			expr:    "cron(0 0 1 1 ? 2027)",
+			from:    "2026-10-19T00:00:00Z",
+			count:   5,
+			options: noOptions,
+			want:    []string{"2027-01-01T00:00:00Z"},
+		},
+		{name: "invalid expression", expr: "0 25 * * *", from: "2026-10-19T00:00:00Z", count: 1, options: noOptions, wantErr: true},
+		{name: "invalid timestamp", expr: "0 3 * * *", from: "2026-10-19", count: 1, options: noOptions, wantErr: true},
+		{name: "zero count", expr: "0 3 * * *", from: "2026-10-19T00:00:00Z", count: 0, options: noOptions, wantErr: true},
+		{name: "unknown time zone", expr: "0 3 * * *", from: "2026-10-19T00:00:00Z", count: 1, timezone: "Mars/Olympus", options: noOptions, wantErr: true},
+		{name: "local time zone", expr: "0 3 * * *", from: "2026-10-19T00:00:00Z", count: 1, timezone: "Local", options: noOptions, wantErr: true},
+		{name: "unknown dialect", expr: "0 3 * * *", from: "2026-10-19T00:00:00Z", count: 1, options: dialect("jenkins"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.expr),
+					types.StringValue(tt.from),
+					types.Int64Value(tt.count),
+					types.StringValue(tt.timezone),
+					tt.options,
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var got []string
+			for _, e := range resp.Result.Value().(basetypes.ListValue).Elements() {
+				got = append(got, e.(basetypes.StringValue).ValueString())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Run() result = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package functions
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateCron(t *testing.T) {
+	tests := []struct {
+		expr    string
+		dialect string
+		want    []string
+	}{
+		{expr: "*/15 9-17 * * MON-FRI", dialect: cronStandard},
+		{expr: "0 0 1,15 * *", dialect: cronStandard},
+		{expr: "0 0 * * 7", dialect: cronStandard},
+		{expr: "@daily", dialect: cronStandard},
+		{expr: "0 0 12 ? * 6#3", dialect: cronQuartz},
+		{expr: "0 0 12 L * ? 2030", dialect: cronQuartz},
+		{expr: "0 15 10 ? * 6L", dialect: cronQuartz},
+		{expr: "0 0 9 LW * ?", dialect: cronQuartz},
+		{expr: "cron(0 10 * * ? *)", dialect: cronAWS},
+		{expr: "0 18 ? * MON-FRI *", dialect: cronAWS},
+		{
+			expr:    "0 0 * *",
+			dialect: cronStandard,
+			want:    []string{"expected 5 fields (minute hour day-of-month month day-of-week), got 4"},
+		},
+		{
+			expr:    "60 24 * * *",
+			dialect: cronStandard,
+			want: []string{
+				`minute field "60": 60 is out of range 0-59`,
+				`hour field "24": 24 is out of range 0-23`,
+			},
+		},
+		{
+			expr:    "0 0 ? * *",
+			dialect: cronStandard,
+			want:    []string{`day-of-month field "?": ? is not supported in this dialect, use *`},
+		},
+		{
+			expr:    "0 0 12 * * MON",
+			dialect: cronQuartz,
+			want:    []string{"one of day-of-month and day-of-week must be ?, since they cannot both be restricted"},
+		},
+		{
+			expr:    "0 10 * * ?",
+			dialect: cronAWS,
+			want:    []string{"expected 6 fields (minute hour day-of-month month day-of-week year), got 5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := ValidateCron(tt.expr, tt.dialect)
+			if err != nil {
+				t.Fatalf("ValidateCron(%q, %q) unexpected error: %v", tt.expr, tt.dialect, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ValidateCron(%q, %q) = %q, want %q", tt.expr, tt.dialect, got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ValidateCron("* * * * *", "jenkins"); err == nil {
+		t.Error("ValidateCron with an unknown dialect did not return an error")
+	}
+}
+
+func TestGuessCronDialect(t *testing.T) {
+	tests := map[string]string{
+		"*/5 * * * *":        cronStandard,
+		"@hourly":            cronStandard,
+		"0 */5 * * * ?":      cronQuartz,
+		"cron(0 10 * * ? *)": cronAWS,
+	}
+	for expr, want := range tests {
+		if got := guessCronDialect(expr); got != want {
+			t.Errorf("guessCronDialect(%q) = %q, want %q", expr, got, want)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		expr    string
+		dialect string
+		from    time.Time
+		count   int
+		want    []string
+	}{
+		{
+			name:  "every 15 minutes",
+			expr:  "*/15 * * * *",
+			from:  time.Date(2026, 3, 1, 10, 7, 0, 0, time.UTC),
+			count: 3,
+			want:  []string{"2026-03-01T10:15:00Z", "2026-03-01T10:30:00Z", "2026-03-01T10:45:00Z"},
+		},
+		{
+			name:  "strictly after from",
+			expr:  "0 12 * * *",
+			from:  time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+			count: 1,
+			want:  []string{"2026-03-02T12:00:00Z"},
+		},
+		{
+			name:  "weekdays",
+			expr:  "30 9 * * MON-FRI",
+			from:  time.Date(2026, 10, 16, 10, 0, 0, 0, time.UTC),
+			count: 2,
+			want:  []string{"2026-10-19T09:30:00Z", "2026-10-20T09:30:00Z"},
+		},
+		{
+			name:  "day of month or day of week",
+			expr:  "0 0 13 * FRI",
+			from:  time.Date(2026, 2, 10, 0, 0, 0, 0, time.UTC),
+			count: 3,
+			want:  []string{"2026-02-13T00:00:00Z", "2026-02-20T00:00:00Z", "2026-02-27T00:00:00Z"},
+		},
+		{
+			name:  "leap day",
+			expr:  "0 0 29 2 *",
+			from:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			count: 2,
+			want:  []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z"},
+		},
+		{
+			name:  "macro",
+			expr:  "@monthly",
+			from:  time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC),
+			count: 1,
+			want:  []string{"2027-01-01T00:00:00Z"},
+		},
+		{
+			name:    "quartz seconds",
+			expr:    "*/20 0 12 * * ?",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+			count:   3,
+			want:    []string{"2026-03-01T12:00:20Z", "2026-03-01T12:00:40Z", "2026-03-02T12:00:00Z"},
+		},
+		{
+			name:    "quartz third friday",
+			expr:    "0 0 12 ? * 6#3",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			count:   2,
+			want:    []string{"2026-01-16T12:00:00Z", "2026-02-20T12:00:00Z"},
+		},
+		{
+			name:    "quartz last friday",
+			expr:    "0 0 12 ? * 6L",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			count:   2,
+			want:    []string{"2026-01-30T12:00:00Z", "2026-02-27T12:00:00Z"},
+		},
+		{
+			name:    "quartz last day",
+			expr:    "0 0 0 L * ?",
+			dialect: cronQuartz,
+			from:    time.Date(2028, 1, 31, 12, 0, 0, 0, time.UTC),
+			count:   2,
+			want:    []string{"2028-02-29T00:00:00Z", "2028-03-31T00:00:00Z"},
+		},
+		{
+			name:    "quartz nearest weekday",
+			expr:    "0 0 0 1W * ?",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC),
+			count:   2,
+			want:    []string{"2026-08-03T00:00:00Z", "2026-09-01T00:00:00Z"},
+		},
+		{
+			name:    "year ends the schedule",
+			expr:    "0 0 0 1 1 ? 2027",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			count:   3,
+			want:    []string{"2027-01-01T00:00:00Z"},
+		},
+		{
+			name:  "aws",
+			expr:  "cron(0 10 ? * MON *)",
+			from:  time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC),
+			count: 1,
+			want:  []string{"2026-10-26T10:00:00Z"},
+		},
+		{
+			name:  "skipped hour",
+			expr:  "30 2 * * *",
+			from:  time.Date(2026, 3, 7, 12, 0, 0, 0, newYork),
+			count: 2,
+			want:  []string{"2026-03-09T02:30:00-04:00", "2026-03-10T02:30:00-04:00"},
+		},
+		{
+			name:  "repeated hour runs once",
+			expr:  "30 1 * * *",
+			from:  time.Date(2026, 10, 31, 12, 0, 0, 0, newYork),
+			count: 2,
+			want:  []string{"2026-11-01T01:30:00-04:00", "2026-11-02T01:30:00-05:00"},
+		},
+		{
+			name:    "repeated hour runs once with seconds",
+			expr:    "15 30 1 * * ?",
+			dialect: cronQuartz,
+			from:    time.Date(2026, 11, 1, 1, 40, 0, 0, newYork),
+			count:   1,
+			want:    []string{"2026-11-02T01:30:15-05:00"},
+		},
+		{
+			name:  "repeated hour keeps wildcard interval",
+			expr:  "*/30 * * * *",
+			from:  time.Date(2026, 11, 1, 0, 50, 0, 0, newYork),
+			count: 5,
+			want: []string{
+				"2026-11-01T01:00:00-04:00", "2026-11-01T01:30:00-04:00",
+				"2026-11-01T01:00:00-05:00", "2026-11-01T01:30:00-05:00",
+				"2026-11-01T02:00:00-05:00",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := CronNext(tt.expr, tt.dialect, tt.from, tt.count)
+			if err != nil {
+				t.Fatalf("CronNext(%q) unexpected error: %v", tt.expr, err)
+			}
+			got := make([]string, len(times))
+			for i, ts := range times {
+				got[i] = ts.Format(time.RFC3339)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("CronNext(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNext_Invalid(t *testing.T) {
+	_, err := CronNext("0 0 12 * * MON", cronQuartz, time.Now(), 1)
+	if err == nil || !strings.Contains(err.Error(), "one of day-of-month and day-of-week must be ?, since they cannot both be restricted") {
+		t.Errorf("CronNext error = %v, want the day field problem", err)
+	}
+}
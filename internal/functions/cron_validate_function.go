@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*cronValidateFunction)(nil)
+
+type cronValidateFunction struct{}
+
+func NewCronValidateFunction() function.Function {
+	return &cronValidateFunction{}
+}
+
+func (f *cronValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_validate"
+}
+
+func (f *cronValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Explains why a cron expression is not valid",
+		Description: "Returns one message per problem, or an empty list if the expression is valid. Dialects: " +
+			"standard is the five-field format of crontab and Kubernetes CronJobs (minute hour day-of-month month day-of-week, with Sunday as 0 or 7), including macros such as @daily; " +
+			"quartz has a leading second field and an optional trailing year field, numbers Sunday as 1, and supports ?, L, W and #; " +
+			"aws is the six-field format of EventBridge schedules (minute hour day-of-month month day-of-week year), optionally wrapped in cron(...), with the same extensions as quartz. " +
+			"In quartz and aws exactly one of day-of-month and day-of-week must be ?.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "The cron expression to check",
+			},
+			function.StringParameter{
+				Name:        "dialect",
+				Description: "The cron dialect: standard, quartz or aws",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *cronValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, dialect string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &dialect))
+	if resp.Error != nil {
+		return
+	}
+
+	problems, err := ValidateCron(expression, dialect)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+	if problems == nil {
+		problems = []string{}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, problems))
+}
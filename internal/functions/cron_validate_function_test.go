@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestCronValidateFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		dialect string
+		want    int
+		wantErr bool
+	}{
+		{name: "valid", expr: "0 3 * * *", dialect: "standard", want: 0},
+		{name: "invalid", expr: "60 24 * * *", dialect: "standard", want: 2},
+		{name: "aws", expr: "cron(0 10 * * ? *)", dialect: "aws", want: 0},
+		{name: "unknown dialect", expr: "0 3 * * *", dialect: "jenkins", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewCronValidateFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.expr),
+					types.StringValue(tt.dialect),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := resp.Result.Value().(basetypes.ListValue)
+			if got.IsNull() {
+				t.Fatal("result is null, want a list")
+			}
+			if len(got.Elements()) != tt.want {
+				t.Errorf("got %d problems, want %d: %v", len(got.Elements()), tt.want, got)
+			}
+		})
+	}
+}
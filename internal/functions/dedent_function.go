@@ -0,0 +1,77 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*dedentFunction)(nil)
+
+type dedentFunction struct{}
+
+func NewDedentFunction() function.Function {
+	return &dedentFunction{}
+}
+
+func (f *dedentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dedent"
+}
+
+func (f *dedentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Removes the indentation common to every line of a text",
+		Description: "Blank lines are ignored when finding the common indentation and are emptied. Tabs and spaces are not interchangeable: " +
+			"a line indented with a tab and one indented with spaces have no indentation in common. Combine with indent to re-indent text.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "The text to dedent",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *dedentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, Dedent(text)))
+}
+
+// Dedent removes the longest run of leading spaces and tabs shared by every
+// non-blank line of text, and empties lines that contain only whitespace.
+func Dedent(text string) string {
+	lines := strings.Split(text, "\n")
+	var common string
+	found := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !found {
+			common, found = indent, true
+			continue
+		}
+		i := 0
+		for i < len(common) && i < len(indent) && common[i] == indent[i] {
+			i++
+		}
+		common = common[:i]
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		} else {
+			lines[i] = line[len(common):]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
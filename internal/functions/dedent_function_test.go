@@ -0,0 +1,52 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"common spaces", "    a\n      b\n    c", "a\n  b\nc"},
+		{"blank lines ignored and emptied", "  a\n\n \n  b", "a\n\n\nb"},
+		{"tabs", "\ta\n\t\tb", "a\n\tb"},
+		{"tabs and spaces differ", "\ta\n    b", "\ta\n    b"},
+		{"shared prefix of mixed indent", " \ta\n  b", "\ta\n b"},
+		{"no indentation", "a\n  b", "a\n  b"},
+		{"heredoc trailing newline", "  a\n  b\n", "a\nb\n"},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Dedent(tt.text); got != tt.want {
+				t.Errorf("Dedent(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedentFunction_Run(t *testing.T) {
+	f := NewDedentFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("  a\n    b")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), "a\n  b")
+}
@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*detectSecretsFunction)(nil)
+
+type detectSecretsFunction struct{}
+
+func NewDetectSecretsFunction() function.Function {
+	return &detectSecretsFunction{}
+}
+
+func (f *detectSecretsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "detect_secrets"
+}
+
+func (f *detectSecretsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Finds credentials in free-form text without revealing them",
+		Description: "Returns a list of objects with type, start and end attributes, ordered by position. start and end are offsets of the secret in grapheme clusters, the characters substr counts, so substr(text, start, end - start) returns it; the secret itself is not returned. " +
+			"Types: " + strings.Join(secretTypes(), ", ") + ". For password assignments and PEM private keys only the value or key body is reported. " +
+			"high_entropy covers tokens of 20 or more characters mixing letters and digits whose Shannon entropy is at least 4 bits per character.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "The text to scan",
+			},
+		},
+		Return: function.ListReturn{ElementType: secretFindingType},
+	}
+}
+
+var secretFindingType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"type":  types.StringType,
+	"start": types.Int64Type,
+	"end":   types.Int64Type,
+}}
+
+func (f *detectSecretsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text))
+	if resp.Error != nil {
+		return
+	}
+
+	findings, err := DetectSecrets(text, DefaultSecretScanOptions())
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, findings))
+}
@@ -0,0 +1,45 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestDetectSecretsFunction_Run(t *testing.T) {
+	f := NewDetectSecretsFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewListNull(secretFindingType))
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("aws_access_key_id = " + testAWSKey),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.ListValue)
+	if !ok {
+		t.Fatalf("result is not ListValue, got %T", resp.Result.Value())
+	}
+	want := types.ListValueMust(secretFindingType, []attr.Value{
+		types.ObjectValueMust(secretFindingType.AttrTypes, map[string]attr.Value{
+			"type":  types.StringValue(secretAWSAccessKey),
+			"start": types.Int64Value(20),
+			"end":   types.Int64Value(40),
+		}),
+	})
+	if !got.Equal(want) {
+		t.Errorf("detect_secrets result = %s, want %s", got, want)
+	}
+}
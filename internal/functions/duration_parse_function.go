@@ -0,0 +1,282 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*durationParseFunction)(nil)
+
+type durationParseFunction struct{}
+
+func NewDurationParseFunction() function.Function {
+	return &durationParseFunction{}
+}
+
+func (f *durationParseFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_parse"
+}
+
+func (f *durationParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parses an ISO 8601 or Go duration",
+		Description: "Accepts ISO 8601 durations such as P1Y2M, P3W or -P1DT2H30M, where only the hours, minutes or seconds may have a fraction, " +
+			"and Go durations such as 1h30m or 250ms. Returns an object with years, months and days, the calendar part of the duration, with weeks counted as 7 days; " +
+			"seconds, the hours, minutes and seconds of the duration in seconds; " +
+			"duration, the whole duration in Go syntax for use with timeadd, counting days as 24 hours, or null if it has years or months, whose length varies; " +
+			"and iso8601, the duration in ISO 8601 syntax. The calendar part can be passed to time_add_calendar.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The duration to parse",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: parsedDurationAttrTypes},
+	}
+}
+
+var parsedDurationAttrTypes = map[string]attr.Type{
+	"years":    types.Int64Type,
+	"months":   types.Int64Type,
+	"days":     types.Int64Type,
+	"seconds":  types.Float64Type,
+	"duration": types.StringType,
+	"iso8601":  types.StringType,
+}
+
+func (f *durationParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ParseDuration(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ParsedDuration is a duration split into a calendar part, whose length
+// depends on the date it is added to, and an exact part.
+type ParsedDuration struct {
+	Years  int `tfsdk:"years"`
+	Months int `tfsdk:"months"`
+	// Days includes weeks, at 7 days each.
+	Days int `tfsdk:"days"`
+	// Seconds is the exact part: the hours, minutes and seconds.
+	Seconds float64 `tfsdk:"seconds"`
+	// Duration is the whole duration in Go syntax, counting days as 24
+	// hours, or nil if there are years or months.
+	Duration *string `tfsdk:"duration"`
+	ISO8601  string  `tfsdk:"iso8601"`
+}
+
+// ParseDuration parses an ISO 8601 duration, recognized by its leading P,
+// or a Go duration as accepted by time.ParseDuration.
+func ParseDuration(s string) (ParsedDuration, error) {
+	var (
+		years, months, days int
+		exact               time.Duration
+	)
+	if strings.HasPrefix(strings.TrimLeft(s, "+-"), "P") {
+		var err error
+		if years, months, days, exact, err = parseISODuration(s); err != nil {
+			return ParsedDuration{}, err
+		}
+	} else {
+		var err error
+		if exact, err = time.ParseDuration(s); err != nil {
+			return ParsedDuration{}, fmt.Errorf("invalid duration %q, expected ISO 8601 syntax such as P1DT2H or Go syntax such as 1h30m", s)
+		}
+	}
+
+	result := ParsedDuration{
+		Years:   years,
+		Months:  months,
+		Days:    days,
+		Seconds: exact.Seconds(),
+		ISO8601: formatISODuration(years, months, days, exact),
+	}
+	if years == 0 && months == 0 {
+		// Days were bounded by parseISODuration so that they fit on their
+		// own, but adding the exact part, of the same sign, still can
+		// overflow.
+		total := time.Duration(days)*24*time.Hour + exact
+		if (days > 0 && total < 0) || (days < 0 && total > 0) {
+			return ParsedDuration{}, fmt.Errorf("duration %q is too long to express in Go syntax", s)
+		}
+		goDuration := total.String()
+		result.Duration = &goDuration
+	}
+	return result, nil
+}
+
+// isoDurationDesignators lists the designators of an ISO 8601 duration in
+// the order they must appear, with those after T in the time part.
+var isoDurationDesignators = []struct {
+	designator byte
+	time       bool
+	unit       string
+}{
+	{'Y', false, ""},
+	{'M', false, ""},
+	{'W', false, ""},
+	{'D', false, ""},
+	{'H', true, "h"},
+	{'M', true, "m"},
+	{'S', true, "s"},
+}
+
+// maxISODurationDays bounds the days of an ISO 8601 duration so that they
+// fit in a time.Duration.
+const maxISODurationDays = int(time.Duration(1<<63-1) / (24 * time.Hour))
+
+// parseISODuration parses "[-]PnYnMnWnDTnHnMnS". Every part is optional,
+// but there must be at least one, and T must be followed by a time part. A
+// leading minus negates every part.
+func parseISODuration(s string) (years, months, days int, exact time.Duration, err error) {
+	invalid := func(reason string) error {
+		return fmt.Errorf("invalid ISO 8601 duration %q: %s", s, reason)
+	}
+
+	rest, negative := s, false
+	switch {
+	case strings.HasPrefix(rest, "-"):
+		rest, negative = rest[1:], true
+	case strings.HasPrefix(rest, "+"):
+		rest = rest[1:]
+	}
+	rest = strings.TrimPrefix(rest, "P")
+
+	next, inTime, parts, fraction := 0, false, 0, false
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime {
+				return 0, 0, 0, 0, invalid("T appears more than once")
+			}
+			inTime = true
+			rest = rest[1:]
+			if rest == "" {
+				return 0, 0, 0, 0, invalid("T must be followed by hours, minutes or seconds")
+			}
+			continue
+		}
+
+		n := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if n <= 0 {
+			return 0, 0, 0, 0, invalid(fmt.Sprintf("expected a number at %q", rest))
+		}
+		number, designator := strings.ReplaceAll(rest[:n], ",", "."), rest[n]
+		rest = rest[n+1:]
+
+		if fraction {
+			return 0, 0, 0, 0, invalid("only the last part may have a fraction")
+		}
+		i := next
+		for i < len(isoDurationDesignators) && (isoDurationDesignators[i].designator != designator || isoDurationDesignators[i].time != inTime) {
+			i++
+		}
+		if i == len(isoDurationDesignators) {
+			if inTime {
+				return 0, 0, 0, 0, invalid(fmt.Sprintf("unexpected %q after T, expected H, M or S in that order", designator))
+			}
+			return 0, 0, 0, 0, invalid(fmt.Sprintf("unexpected %q, expected Y, M, W or D in that order, with hours, minutes and seconds after T", designator))
+		}
+		next = i + 1
+		parts++
+		fraction = strings.Contains(number, ".")
+
+		d := isoDurationDesignators[i]
+		if d.time {
+			v, perr := time.ParseDuration(number + d.unit)
+			if perr != nil || exact+v < exact {
+				return 0, 0, 0, 0, invalid("the hours, minutes and seconds are too long")
+			}
+			exact += v
+			continue
+		}
+		if fraction {
+			return 0, 0, 0, 0, invalid(fmt.Sprintf("the %c part must be a whole number", designator))
+		}
+		v, perr := strconv.Atoi(number)
+		if perr != nil || v > maxISODurationDays/7 {
+			return 0, 0, 0, 0, invalid(fmt.Sprintf("the %c part is too large", designator))
+		}
+		switch designator {
+		case 'Y':
+			years = v
+		case 'M':
+			months = v
+		case 'W':
+			days += 7 * v
+		case 'D':
+			days += v
+		}
+		if days > maxISODurationDays {
+			return 0, 0, 0, 0, invalid("the days are too many")
+		}
+	}
+	if parts == 0 {
+		return 0, 0, 0, 0, invalid("expected at least one part, such as P1D or PT1H")
+	}
+
+	if negative {
+		return -years, -months, -days, -exact, nil
+	}
+	return years, months, days, exact, nil
+}
+
+// formatISODuration formats a duration as ISO 8601, with weeks as days and
+// without carrying hours into days, which are not always 24 hours long.
+func formatISODuration(years, months, days int, exact time.Duration) string {
+	var b strings.Builder
+	if years < 0 || months < 0 || days < 0 || exact < 0 {
+		b.WriteByte('-')
+		years, months, days, exact = -years, -months, -days, -exact
+	}
+	b.WriteByte('P')
+	for _, part := range []struct {
+		value      int
+		designator byte
+	}{{years, 'Y'}, {months, 'M'}, {days, 'D'}} {
+		if part.value != 0 {
+			b.WriteString(strconv.Itoa(part.value))
+			b.WriteByte(part.designator)
+		}
+	}
+
+	if exact == 0 {
+		if b.Len() == 1 {
+			return "PT0S"
+		}
+		return b.String()
+	}
+	b.WriteByte('T')
+	hours, minutes := exact/time.Hour, exact%time.Hour/time.Minute
+	seconds, nanos := exact%time.Minute/time.Second, exact%time.Second
+	if hours != 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes != 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if seconds != 0 || nanos != 0 {
+		fmt.Fprintf(&b, "%d", seconds)
+		if nanos != 0 {
+			b.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
@@ -0,0 +1,120 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestParseDuration(t *testing.T) {
+	goDuration := func(s string) *string { return &s }
+
+	tests := []struct {
+		input   string
+		want    ParsedDuration
+		wantErr bool
+	}{
+		{input: "P1DT2H", want: ParsedDuration{Days: 1, Seconds: 7200, Duration: goDuration("26h0m0s"), ISO8601: "P1DT2H"}},
+		{input: "P1Y2M3D", want: ParsedDuration{Years: 1, Months: 2, Days: 3, ISO8601: "P1Y2M3D"}},
+		{input: "P2W", want: ParsedDuration{Days: 14, Duration: goDuration("336h0m0s"), ISO8601: "P14D"}},
+		{input: "PT1.5S", want: ParsedDuration{Seconds: 1.5, Duration: goDuration("1.5s"), ISO8601: "PT1.5S"}},
+		{input: "PT0,25H", want: ParsedDuration{Seconds: 900, Duration: goDuration("15m0s"), ISO8601: "PT15M"}},
+		{input: "PT36H", want: ParsedDuration{Seconds: 129600, Duration: goDuration("36h0m0s"), ISO8601: "PT36H"}},
+		{input: "-P1DT30M", want: ParsedDuration{Days: -1, Seconds: -1800, Duration: goDuration("-24h30m0s"), ISO8601: "-P1DT30M"}},
+		{input: "PT0S", want: ParsedDuration{Duration: goDuration("0s"), ISO8601: "PT0S"}},
+		{input: "1h30m", want: ParsedDuration{Seconds: 5400, Duration: goDuration("1h30m0s"), ISO8601: "PT1H30M"}},
+		{input: "-250ms", want: ParsedDuration{Seconds: -0.25, Duration: goDuration("-250ms"), ISO8601: "-PT0.25S"}},
+		{input: "P", wantErr: true},
+		{input: "P1DT", wantErr: true},
+		{input: "PT1D", wantErr: true},
+		{input: "P1H", wantErr: true},
+		{input: "P1D2Y", wantErr: true},
+		{input: "P1.5D", wantErr: true},
+		{input: "PT1.5H30M", wantErr: true},
+		{input: "P99999999D", wantErr: true},
+		{input: "PT9999999999H", wantErr: true},
+		{input: "1d", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (got.Duration == nil) != (tt.want.Duration == nil) || got.Duration != nil && *got.Duration != *tt.want.Duration {
+				t.Errorf("ParseDuration(%q).Duration = %v, want %v", tt.input, got.Duration, tt.want.Duration)
+			}
+			got.Duration, tt.want.Duration = nil, nil
+			if got != tt.want {
+				t.Errorf("ParseDuration(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationParseFunction_Run(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    map[string]attr.Value
+		wantErr bool
+	}{
+		{
+			name:  "calendar",
+			input: "P1M",
+			want: map[string]attr.Value{
+				"years":    types.Int64Value(0),
+				"months":   types.Int64Value(1),
+				"days":     types.Int64Value(0),
+				"seconds":  types.Float64Value(0),
+				"duration": types.StringNull(),
+				"iso8601":  types.StringValue("P1M"),
+			},
+		},
+		{
+			name:  "go",
+			input: "90s",
+			want: map[string]attr.Value{
+				"years":    types.Int64Value(0),
+				"months":   types.Int64Value(0),
+				"days":     types.Int64Value(0),
+				"seconds":  types.Float64Value(90),
+				"duration": types.StringValue("1m30s"),
+				"iso8601":  types.StringValue("PT1M30S"),
+			},
+		},
+		{name: "invalid", input: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewDurationParseFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.input)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(types.ObjectNull(parsedDurationAttrTypes))}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := types.ObjectValueMust(parsedDurationAttrTypes, tt.want)
+			if got := resp.Result.Value().(basetypes.ObjectValue); !got.Equal(want) {
+				t.Errorf("Run() result = %v, want %v", got, want)
+			}
+		})
+	}
+}
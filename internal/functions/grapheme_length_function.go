@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/rivo/uniseg"
+)
+
+var _ function.Function = (*graphemeLengthFunction)(nil)
+
+type graphemeLengthFunction struct{}
+
+func NewGraphemeLengthFunction() function.Function {
+	return &graphemeLengthFunction{}
+}
+
+func (f *graphemeLengthFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "grapheme_length"
+}
+
+func (f *graphemeLengthFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Counts the user-perceived characters in a string",
+		Description: "Characters are extended grapheme clusters as defined by Unicode Standard Annex #29, so a flag, an emoji with a skin tone modifier or a family emoji each count as one, " +
+			"as does a letter followed by combining accents.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to measure",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *graphemeLengthFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, int64(GraphemeLength(input))))
+}
+
+// GraphemeLength returns the number of extended grapheme clusters in s.
+func GraphemeLength(s string) int {
+	return uniseg.GraphemeClusterCount(s)
+}
@@ -0,0 +1,145 @@
+package functions
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// graphemeBreakTests are taken from the Unicode 15.0.0 GraphemeBreakTest.txt
+// (https://www.unicode.org/Public/15.0.0/ucd/auxiliary/GraphemeBreakTest.txt).
+// "÷" marks a boundary between grapheme clusters and "×" marks no boundary.
+var graphemeBreakTests = []string{
+	"÷ 0020 × 0308 ÷ 0020 ÷",
+	"÷ 000D × 000A ÷ 0061 ÷ 000A ÷ 0308 ÷",
+	"÷ 0061 × 0308 ÷ 0062 ÷",
+	"÷ 0061 × 0903 ÷ 0062 ÷",
+	"÷ 0061 ÷ 0600 × 0062 ÷",
+	"÷ 0020 × 200D ÷ 0646 ÷",
+	"÷ 1100 × 1100 ÷",
+	"÷ AC00 × 11A8 ÷ 1100 ÷",
+	"÷ AC01 × 11A8 ÷ 1100 ÷",
+	"÷ 1F1E6 × 1F1E7 ÷ 1F1E8 ÷ 0062 ÷",
+	"÷ 0061 ÷ 1F1E6 × 1F1E7 ÷ 1F1E8 ÷ 0062 ÷",
+	"÷ 0061 ÷ 1F1E6 × 1F1E7 × 200D ÷ 1F1E8 ÷ 0062 ÷",
+	"÷ 0061 ÷ 1F1E6 × 200D ÷ 1F1E7 × 1F1E8 ÷ 0062 ÷",
+	"÷ 0061 ÷ 1F1E6 × 1F1E7 ÷ 1F1E8 × 1F1E9 ÷ 0062 ÷",
+	"÷ 1F476 × 1F3FF ÷ 1F476 ÷",
+	"÷ 0061 × 1F3FF ÷ 1F476 × 200D × 1F6D1 ÷",
+	"÷ 1F476 × 1F3FF × 0308 × 200D × 1F476 × 1F3FF ÷",
+	"÷ 1F6D1 × 200D × 1F6D1 ÷",
+	"÷ 0061 × 200D ÷ 1F6D1 ÷",
+	"÷ 2701 × 200D × 2701 ÷",
+	"÷ 0061 × 200D ÷ 2701 ÷",
+}
+
+// parseGraphemeBreakTest returns the string described by a test line and
+// its expected grapheme clusters.
+func parseGraphemeBreakTest(t *testing.T, line string) (string, []string) {
+	t.Helper()
+	var clusters []string
+	var current strings.Builder
+	for _, field := range strings.Fields(line) {
+		switch field {
+		case "÷":
+			if current.Len() > 0 {
+				clusters = append(clusters, current.String())
+				current.Reset()
+			}
+		case "×":
+		default:
+			cp, err := strconv.ParseUint(field, 16, 32)
+			if err != nil {
+				t.Fatalf("invalid code point %q in %q", field, line)
+			}
+			current.WriteRune(rune(cp))
+		}
+	}
+	return strings.Join(clusters, ""), clusters
+}
+
+func TestGraphemeBreakVectors(t *testing.T) {
+	for _, line := range graphemeBreakTests {
+		t.Run(line, func(t *testing.T) {
+			input, clusters := parseGraphemeBreakTest(t, line)
+
+			if got := GraphemeLength(input); got != len(clusters) {
+				t.Errorf("GraphemeLength() = %d, want %d", got, len(clusters))
+			}
+
+			mask := DefaultMaskOptions()
+			mask.Unit = unitGraphemes
+			mask.ShowLast = 1
+			masked, err := MaskWithOptions(input, mask)
+			if err != nil {
+				t.Fatalf("MaskWithOptions() error = %v", err)
+			}
+			assertEqual(t, masked, strings.Repeat("*", len(clusters)-1)+clusters[len(clusters)-1])
+
+			truncate := DefaultTruncateOptions()
+			truncate.Unit = unitGraphemes
+			truncate.HashLength = 0
+			truncated, err := TruncateWithOptions(input, 1, truncate)
+			if err != nil {
+				t.Fatalf("TruncateWithOptions() error = %v", err)
+			}
+			assertEqual(t, truncated, clusters[0])
+		})
+	}
+}
+
+func TestGraphemeLength(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{name: "ascii", input: "hello", want: 5},
+		{name: "empty", input: "", want: 0},
+		{name: "combining accent", input: "é", want: 1},
+		{name: "flag", input: "\U0001F1E9\U0001F1EA", want: 1},
+		{name: "family", input: "\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466", want: 1},
+		{name: "mixed", input: "hi \U0001F44B\U0001F3FD!", want: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GraphemeLength(tt.input); got != tt.want {
+				t.Errorf("GraphemeLength(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphemeLengthFunction_Run(t *testing.T) {
+	f := NewGraphemeLengthFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewInt64Null())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("\U0001F468\u200D\U0001F469\u200D\U0001F467 family"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.Int64Value)
+	if !ok {
+		t.Fatalf("result is not Int64Value, got %T", resp.Result.Value())
+	}
+	if got.ValueInt64() != 8 {
+		t.Errorf("grapheme_length result = %d, want 8", got.ValueInt64())
+	}
+}
@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*intToIPFunction)(nil)
+
+type intToIPFunction struct{}
+
+func NewIntToIPFunction() function.Function {
+	return &intToIPFunction{}
+}
+
+func (f *intToIPFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "int_to_ip"
+}
+
+func (f *intToIPFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts an integer to an IPv4 or IPv6 address",
+		Description: "The reverse of ip_to_int: 167772161 becomes 10.0.0.1 as IPv4, or ::a00:1 as IPv6. The integer must fit in 32 bits for IPv4 and 128 bits for IPv6.",
+		Parameters: []function.Parameter{
+			function.NumberParameter{
+				Name:        "integer",
+				Description: "The non-negative integer to convert",
+			},
+			function.Int64Parameter{
+				Name:        "ip_version",
+				Description: "The IP version of the result: 4 or 6",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *intToIPFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var n *big.Float
+	var version int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &n, &version))
+	if resp.Error != nil {
+		return
+	}
+
+	i, acc := n.Int(nil)
+	if acc != big.Exact {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("integer must be a whole number, got %s", n.Text('g', -1)))
+		return
+	}
+	if version != 4 && version != 6 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("ip_version must be 4 or 6, got %d", version))
+		return
+	}
+
+	result, err := IntToIP(i, version == 4)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// IntToIP returns n as an IPv4 address, or an IPv6 address unless is4.
+func IntToIP(n *big.Int, is4 bool) (string, error) {
+	addr, ok := intToAddr(n, is4)
+	if !ok {
+		bits := 128
+		if is4 {
+			bits = 32
+		}
+		return "", fmt.Errorf("integer %s is out of range, expected 0 to 2^%d-1", n, bits)
+	}
+	return addr.String(), nil
+}
@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIntToIP(t *testing.T) {
+	tests := []struct {
+		n       string
+		is4     bool
+		want    string
+		wantErr bool
+	}{
+		{n: "167772161", is4: true, want: "10.0.0.1"},
+		{n: "167772161", want: "::a00:1"},
+		{n: "0", is4: true, want: "0.0.0.0"},
+		{n: "4294967295", is4: true, want: "255.255.255.255"},
+		{n: "4294967296", is4: true, wantErr: true},
+		{n: "42540766411282592856903984951653826561", want: "2001:db8::1"},
+		{n: "340282366920938463463374607431768211456", wantErr: true},
+		{n: "-1", is4: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.n, func(t *testing.T) {
+			n, _ := new(big.Int).SetString(tt.n, 10)
+			got, err := IntToIP(n, tt.is4)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IntToIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IntToIP(%s, %v) = %q, want %q", tt.n, tt.is4, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntToIPFunction_Run(t *testing.T) {
+	f := NewIntToIPFunction()
+
+	tests := []struct {
+		name    string
+		n       *big.Float
+		version int64
+		want    string
+		wantErr bool
+	}{
+		{name: "ipv4", n: bigFloat(167772161), version: 4, want: "10.0.0.1"},
+		{name: "ipv6", n: bigFloat(1), version: 6, want: "::1"},
+		{name: "fraction", n: bigFloat(1.5), version: 4, wantErr: true},
+		{name: "bad version", n: bigFloat(1), version: 5, wantErr: true},
+		{name: "out of range", n: bigFloat(1 << 40), version: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.NumberValue(tt.n), types.Int64Value(tt.version)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), tt.want)
+			}
+		})
+	}
+}
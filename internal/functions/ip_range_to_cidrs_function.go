@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*ipRangeToCIDRsFunction)(nil)
+
+type ipRangeToCIDRsFunction struct{}
+
+func NewIPRangeToCIDRsFunction() function.Function {
+	return &ipRangeToCIDRsFunction{}
+}
+
+func (f *ipRangeToCIDRsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_range_to_cidrs"
+}
+
+func (f *ipRangeToCIDRsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts an inclusive range of IP addresses into the fewest CIDR blocks covering exactly that range",
+		Description: "For example 10.0.0.5 to 10.0.0.20 becomes 10.0.0.5/32, 10.0.0.6/31, 10.0.0.8/29, 10.0.0.16/30 and 10.0.0.20/32. Both addresses must be of the same IP version.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "start",
+				Description: "The first address of the range",
+			},
+			function.StringParameter{
+				Name:        "end",
+				Description: "The last address of the range",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *ipRangeToCIDRsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var start, end string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &start, &end))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := IPRangeToCIDRs(start, end)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// IPRangeToCIDRs returns the smallest list of CIDR blocks, ordered by
+// address, that together hold exactly the addresses from start to end.
+func IPRangeToCIDRs(start, end string) ([]string, error) {
+	first, err := parseAddr(start)
+	if err != nil {
+		return nil, err
+	}
+	last, err := parseAddr(end)
+	if err != nil {
+		return nil, err
+	}
+	if first.Is4() != last.Is4() {
+		return nil, fmt.Errorf("start %s and end %s are of different IP versions", first, last)
+	}
+	if last.Less(first) {
+		return nil, fmt.Errorf("end %s is before start %s", last, first)
+	}
+
+	all := netip.PrefixFrom(netip.IPv6Unspecified(), 0)
+	if first.Is4() {
+		all = netip.PrefixFrom(netip.IPv4Unspecified(), 0)
+	}
+	return prefixStrings(rangePrefixes(all, first, last)), nil
+}
+
+// rangePrefixes returns the largest prefixes within p whose addresses all
+// lie between first and last, ordered by address.
+func rangePrefixes(p netip.Prefix, first, last netip.Addr) []netip.Prefix {
+	lo, hi := p.Addr(), prefixLast(p)
+	if hi.Less(first) || last.Less(lo) {
+		return nil
+	}
+	if !lo.Less(first) && !last.Less(hi) {
+		return []netip.Prefix{p}
+	}
+	lower, upper := prefixHalves(p)
+	return append(rangePrefixes(lower, first, last), rangePrefixes(upper, first, last)...)
+}
@@ -0,0 +1,64 @@
+package functions
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPRangeToCIDRs(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		want       []string
+		wantErr    bool
+	}{
+		{name: "vendor range", start: "10.0.0.5", end: "10.0.1.20", want: []string{
+			"10.0.0.5/32", "10.0.0.6/31", "10.0.0.8/29", "10.0.0.16/28", "10.0.0.32/27", "10.0.0.64/26", "10.0.0.128/25",
+			"10.0.1.0/28", "10.0.1.16/30", "10.0.1.20/32",
+		}},
+		{name: "aligned block", start: "192.168.0.0", end: "192.168.0.255", want: []string{"192.168.0.0/24"}},
+		{name: "single address", start: "192.168.0.7", end: "192.168.0.7", want: []string{"192.168.0.7/32"}},
+		{name: "everything", start: "0.0.0.0", end: "255.255.255.255", want: []string{"0.0.0.0/0"}},
+		{name: "ipv6", start: "2001:db8::", end: "2001:db8::2", want: []string{"2001:db8::/127", "2001:db8::2/128"}},
+		{name: "end before start", start: "10.0.0.2", end: "10.0.0.1", wantErr: true},
+		{name: "mixed versions", start: "10.0.0.1", end: "::1", wantErr: true},
+		{name: "invalid", start: "10.0.0", end: "10.0.0.1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IPRangeToCIDRs(tt.start, tt.end)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IPRangeToCIDRs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("IPRangeToCIDRs(%s, %s) = %v, want %v", tt.start, tt.end, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPRangeToCIDRsFunction_Run(t *testing.T) {
+	f := NewIPRangeToCIDRsFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0"), types.StringValue("10.0.0.2")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewListNull(types.StringType))}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/31"), types.StringValue("10.0.0.2/32")})
+	if got := resp.Result.Value(); !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*ipToIntFunction)(nil)
+
+type ipToIntFunction struct{}
+
+func NewIPToIntFunction() function.Function {
+	return &ipToIntFunction{}
+}
+
+func (f *ipToIntFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_to_int"
+}
+
+func (f *ipToIntFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Converts an IPv4 or IPv6 address to an integer",
+		Description: "The address is read as an unsigned big-endian number, so 10.0.0.1 becomes 167772161. Terraform numbers hold IPv6 addresses exactly. int_to_ip reverses the conversion.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "The IP address to convert",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+func (f *ipToIntFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip))
+	if resp.Error != nil {
+		return
+	}
+
+	n, err := IPToInt(ip)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, new(big.Float).SetInt(n)))
+}
+
+// IPToInt returns the IP address s as an unsigned integer.
+func IPToInt(s string) (*big.Int, error) {
+	addr, err := parseAddr(s)
+	if err != nil {
+		return nil, err
+	}
+	return addrToInt(addr), nil
+}
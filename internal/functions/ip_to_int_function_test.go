@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPToInt(t *testing.T) {
+	tests := []struct {
+		ip      string
+		want    string
+		wantErr bool
+	}{
+		{ip: "0.0.0.0", want: "0"},
+		{ip: "10.0.0.1", want: "167772161"},
+		{ip: "255.255.255.255", want: "4294967295"},
+		{ip: "::1", want: "1"},
+		{ip: "2001:db8::1", want: "42540766411282592856903984951653826561"},
+		{ip: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", want: "340282366920938463463374607431768211455"},
+		{ip: "::ffff:10.0.0.1", want: "281470849515521"},
+		{ip: "fe80::1%eth0", wantErr: true},
+		{ip: "10.0.0.256", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			got, err := IPToInt(tt.ip)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IPToInt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("IPToInt(%q) = %s, want %s", tt.ip, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPToIntFunction_Run(t *testing.T) {
+	f := NewIPToIntFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("2001:db8::1")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewNumberNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	got, _ := resp.Result.Value().(basetypes.NumberValue).ValueBigFloat().Int(nil)
+	want, _ := new(big.Int).SetString("42540766411282592856903984951653826561", 10)
+	if got.Cmp(want) != 0 {
+		t.Errorf("Run() result = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*ipv6EUI64Function)(nil)
+
+type ipv6EUI64Function struct{}
+
+func NewIPv6EUI64Function() function.Function {
+	return &ipv6EUI64Function{}
+}
+
+func (f *ipv6EUI64Function) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_eui64"
+}
+
+func (f *ipv6EUI64Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds an IPv6 address from a prefix and the modified EUI-64 interface ID of a MAC address",
+		Description: "As in stateless address autoconfiguration (RFC 4291 appendix A), ff:fe is inserted in the middle of a 48-bit MAC address and the universal/local bit is inverted, " +
+			"so 2001:db8::/64 and 00:1a:2b:3c:4d:5e give 2001:db8::21a:2bff:fe3c:4d5e. The MAC address may use colons, dashes or dots, and may also be a 64-bit EUI-64. " +
+			"The prefix must be /64 or shorter; the interface ID replaces the low 64 bits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The IPv6 network prefix, usually a /64",
+			},
+			function.StringParameter{
+				Name:        "mac",
+				Description: "The MAC address of the interface",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ipv6EUI64Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, mac string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &mac))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := netip.ParsePrefix(prefix)
+	if err != nil || !p.Addr().Is6() || p.Addr().Zone() != "" {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid IPv6 prefix %q", prefix))
+		return
+	}
+	hw, err := net.ParseMAC(mac)
+	if err != nil || (len(hw) != 6 && len(hw) != 8) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid MAC address %q", mac))
+		return
+	}
+
+	result, err := IPv6EUI64(p, hw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// IPv6EUI64 combines the upper 64 bits of prefix with the modified EUI-64
+// interface ID derived from a 48-bit MAC or 64-bit EUI-64 address.
+func IPv6EUI64(prefix netip.Prefix, mac net.HardwareAddr) (string, error) {
+	if prefix.Bits() > 64 {
+		return "", fmt.Errorf("prefix %s is longer than /64, leaving no room for an interface ID", prefix)
+	}
+
+	var id []byte
+	switch len(mac) {
+	case 6:
+		id = []byte{mac[0], mac[1], mac[2], 0xff, 0xfe, mac[3], mac[4], mac[5]}
+	case 8:
+		id = []byte(mac)
+	default:
+		return "", fmt.Errorf("MAC address %s must be 48 or 64 bits long", mac)
+	}
+
+	raw := prefix.Masked().Addr().As16()
+	copy(raw[8:], id)
+	raw[8] ^= 0x02
+	return netip.AddrFrom16(raw).String(), nil
+}
@@ -0,0 +1,83 @@
+package functions
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPv6EUI64(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		mac     string
+		want    string
+		wantErr bool
+	}{
+		{name: "colons", prefix: "2001:db8::/64", mac: "00:1a:2b:3c:4d:5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "dashes upper-case", prefix: "2001:db8:1:2::/64", mac: "00-1A-2B-3C-4D-5E", want: "2001:db8:1:2:21a:2bff:fe3c:4d5e"},
+		{name: "dots", prefix: "2001:db8::/64", mac: "001a.2b3c.4d5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "locally administered bit cleared", prefix: "fe80::/64", mac: "02:00:5e:10:00:01", want: "fe80::5eff:fe10:1"},
+		{name: "eui-64", prefix: "2001:db8::/64", mac: "00:1a:2b:ff:fe:3c:4d:5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "shorter prefix", prefix: "2001:db8::/48", mac: "00:1a:2b:3c:4d:5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "host bits of prefix replaced", prefix: "2001:db8::1/64", mac: "00:1a:2b:3c:4d:5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "prefix too long", prefix: "2001:db8::/80", mac: "00:1a:2b:3c:4d:5e", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mac, err := net.ParseMAC(tt.mac)
+			if err != nil {
+				t.Fatalf("ParseMAC(%q): %s", tt.mac, err)
+			}
+			got, err := IPv6EUI64(netip.MustParsePrefix(tt.prefix), mac)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IPv6EUI64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IPv6EUI64(%s, %s) = %q, want %q", tt.prefix, tt.mac, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPv6EUI64Function_Run(t *testing.T) {
+	f := NewIPv6EUI64Function()
+
+	tests := []struct {
+		name    string
+		prefix  string
+		mac     string
+		want    string
+		wantErr bool
+	}{
+		{name: "valid", prefix: "2001:db8::/64", mac: "00:1a:2b:3c:4d:5e", want: "2001:db8::21a:2bff:fe3c:4d5e"},
+		{name: "ipv4 prefix", prefix: "10.0.0.0/8", mac: "00:1a:2b:3c:4d:5e", wantErr: true},
+		{name: "invalid mac", prefix: "2001:db8::/64", mac: "00:1a:2b", wantErr: true},
+		{name: "infiniband address", prefix: "2001:db8::/64", mac: "00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.prefix), types.StringValue(tt.mac)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*ipv6NAT64Function)(nil)
+
+type ipv6NAT64Function struct{}
+
+func NewIPv6NAT64Function() function.Function {
+	return &ipv6NAT64Function{}
+}
+
+func (f *ipv6NAT64Function) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_nat64"
+}
+
+func (f *ipv6NAT64Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Embeds an IPv4 address in a NAT64 IPv6 prefix",
+		Description: "The address is placed as described in RFC 6052 section 2.2, so 192.0.2.33 with the well-known prefix 64:ff9b::/96 gives 64:ff9b::c000:221. " +
+			"The prefix length must be 32, 40, 48, 56, 64 or 96; bits 64 to 71 of the result are always zero.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ipv4",
+				Description: "The IPv4 address to embed",
+			},
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The NAT64 prefix, such as 64:ff9b::/96",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ipv6NAT64Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipv4, prefix string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ipv4, &prefix))
+	if resp.Error != nil {
+		return
+	}
+
+	addr, err := parseAddr(ipv4)
+	if err != nil || !addr.Is4() {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid IPv4 address %q", ipv4))
+		return
+	}
+	p, err := parseCIDR(prefix)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := IPv6NAT64(addr, p)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// IPv6NAT64 returns the IPv4-embedded IPv6 address of addr under prefix,
+// following RFC 6052: the IPv4 bytes follow the prefix, skipping byte 8,
+// which is reserved and zero.
+func IPv6NAT64(addr netip.Addr, prefix netip.Prefix) (string, error) {
+	if !prefix.Addr().Is6() {
+		return "", fmt.Errorf("prefix %s is not an IPv6 prefix", prefix)
+	}
+	switch prefix.Bits() {
+	case 32, 40, 48, 56, 64, 96:
+	default:
+		return "", fmt.Errorf("prefix length of %s must be 32, 40, 48, 56, 64 or 96", prefix)
+	}
+
+	raw := prefix.Masked().Addr().As16()
+	if raw[8] != 0 {
+		return "", fmt.Errorf("bits 64 to 71 of prefix %s must be zero", prefix)
+	}
+	i := prefix.Bits() / 8
+	for _, b := range addr.As4() {
+		if i == 8 {
+			i++
+		}
+		raw[i] = b
+		i++
+	}
+	return netip.AddrFrom16(raw).String(), nil
+}
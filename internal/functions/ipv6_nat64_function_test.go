@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPv6NAT64(t *testing.T) {
+	// The examples of RFC 6052 section 2.4, embedding 192.0.2.33.
+	tests := []struct {
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{prefix: "2001:db8::/32", want: "2001:db8:c000:221::"},
+		{prefix: "2001:db8:100::/40", want: "2001:db8:1c0:2:21::"},
+		{prefix: "2001:db8:122::/48", want: "2001:db8:122:c000:2:2100::"},
+		{prefix: "2001:db8:122:300::/56", want: "2001:db8:122:3c0:0:221::"},
+		{prefix: "2001:db8:122:344::/64", want: "2001:db8:122:344:c0:2:2100:0"},
+		{prefix: "2001:db8:122:344::/96", want: "2001:db8:122:344::c000:221"},
+		{prefix: "64:ff9b::/96", want: "64:ff9b::c000:221"},
+		{prefix: "2001:db8::/44", wantErr: true},
+		{prefix: "2001:db8:0:0:100::/96", wantErr: true},
+		{prefix: "10.0.0.0/8", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			got, err := IPv6NAT64(netip.MustParseAddr("192.0.2.33"), netip.MustParsePrefix(tt.prefix))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IPv6NAT64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IPv6NAT64(192.0.2.33, %s) = %q, want %q", tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPv6NAT64Function_Run(t *testing.T) {
+	f := NewIPv6NAT64Function()
+
+	tests := []struct {
+		name    string
+		ipv4    string
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{name: "well-known prefix", ipv4: "192.0.2.33", prefix: "64:ff9b::/96", want: "64:ff9b::c000:221"},
+		{name: "ipv6 input", ipv4: "2001:db8::1", prefix: "64:ff9b::/96", wantErr: true},
+		{name: "host bits in prefix", ipv4: "192.0.2.33", prefix: "64:ff9b::1/96", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.ipv4), types.StringValue(tt.prefix)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*ipv6NormalizeFunction)(nil)
+
+type ipv6NormalizeFunction struct{}
+
+func NewIPv6NormalizeFunction() function.Function {
+	return &ipv6NormalizeFunction{}
+}
+
+func (f *ipv6NormalizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_normalize"
+}
+
+func (f *ipv6NormalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Rewrites an IPv6 address or CIDR block in the canonical form of RFC 5952",
+		Description: "Hex digits are lowercased, leading zeros are dropped, and the longest run of two or more zero groups is replaced with ::, the first run on a tie, " +
+			"so 2001:0DB8:0000:0000:0001:0000:0000:0001 becomes 2001:db8::1:0:0:1. IPv4-mapped addresses keep their dotted suffix, as in ::ffff:192.0.2.1. " +
+			"A CIDR block keeps its prefix length; its host bits are not cleared.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "The IPv6 address or CIDR block to normalize",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ipv6NormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := IPv6Normalize(ip)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// IPv6Normalize returns the RFC 5952 form of an IPv6 address, or of a CIDR
+// block with its prefix length. Zones are kept.
+func IPv6Normalize(s string) (string, error) {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil || !p.Addr().Is6() {
+			return "", fmt.Errorf("invalid IPv6 CIDR %q", s)
+		}
+		return p.String(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil || !addr.Is6() {
+		return "", fmt.Errorf("invalid IPv6 address %q", s)
+	}
+	return addr.String(), nil
+}
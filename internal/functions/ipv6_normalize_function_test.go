@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestIPv6Normalize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "2001:0DB8:0000:0000:0001:0000:0000:0001", want: "2001:db8::1:0:0:1"},
+		{input: "2001:db8:0:0:0:0:2:1", want: "2001:db8::2:1"},
+		{input: "2001:db8:0:1:1:1:1:1", want: "2001:db8:0:1:1:1:1:1"},
+		{input: "2001:0:0:1:0:0:0:1", want: "2001:0:0:1::1"},
+		{input: "0:0:0:0:0:0:0:0", want: "::"},
+		{input: "::ffff:c000:0201", want: "::ffff:192.0.2.1"},
+		{input: "FE80::0001%eth0", want: "fe80::1%eth0"},
+		{input: "2001:0db8:0000::/48", want: "2001:db8::/48"},
+		{input: "10.0.0.1", wantErr: true},
+		{input: "10.0.0.0/8", wantErr: true},
+		{input: "2001:db8::g", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := IPv6Normalize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IPv6Normalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IPv6Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIPv6NormalizeFunction_Run(t *testing.T) {
+	f := NewIPv6NormalizeFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("2001:DB8:0:0::1")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), "2001:db8::1")
+}
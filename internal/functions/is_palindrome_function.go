@@ -2,10 +2,11 @@ package functions
 
 import (
 	"context"
+	"fmt"
 	"strings"
-	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ function.Function = (*isPalindromeFunction)(nil)
@@ -23,43 +24,262 @@ func (f *isPalindromeFunction) Metadata(_ context.Context, _ function.MetadataRe
 func (f *isPalindromeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary: "Checks whether a string is a palindrome",
+		Description: "The check is case-insensitive and by default ignores everything but letters and digits. " +
+			"Options: normalize (NFC, NFD, NFKC or NFKD) applies a Unicode normalization form first, e.g. NFKC so that full-width letters match; " +
+			"fold_accents removes accents so that é matches e; case_fold uses full Unicode case folding instead of lowercasing, so that ß matches ss; " +
+			"ignore is the list of character classes to skip (default [\"non_alphanumeric\"]): " + strings.Join(unicodeClassNames(), ", ") + ".",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
 				Description: "The string to check",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with normalize, fold_accents, case_fold and ignore attributes",
+		},
 		Return: function.BoolReturn{},
 	}
 }
 
 func (f *isPalindromeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
-	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &optionArgs))
 	if resp.Error != nil {
 		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, IsPalindrome(input)))
+	opts, err := parsePalindromeOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := IsPalindromeWithOptions(input, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func parsePalindromeOptions(args []types.Dynamic) (NormalizeOptions, error) {
+	return parseNormalizeOptions(args, "normalize", "fold_accents", "case_fold", "ignore")
 }
 
 // IsPalindrome checks whether s is a palindrome.
 // It is case-insensitive and ignores non-alphanumeric characters.
 func IsPalindrome(s string) bool {
-	s = strings.ToLower(s)
+	result, _ := IsPalindromeWithOptions(s, NormalizeOptions{})
+	return result
+}
 
-	// Keep only alphanumeric runes.
-	filtered := make([]rune, 0, len(s))
-	for _, r := range s {
-		if unicode.IsLetter(r) || unicode.IsDigit(r) {
-			filtered = append(filtered, r)
-		}
+// IsPalindromeWithOptions checks whether s is a palindrome after the
+// filtering described at palindromeChars.
+func IsPalindromeWithOptions(s string, opts NormalizeOptions) (bool, error) {
+	filtered, err := palindromeChars(s, opts)
+	if err != nil {
+		return false, err
 	}
 
 	for i, j := 0, len(filtered)-1; i < j; i, j = i+1, j-1 {
 		if filtered[i] != filtered[j] {
-			return false
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// palindromeChars returns the characters of s that palindrome checks
+// compare. Unless opts.CaseFold asks for full case folding, s is lowercased;
+// it is then passed through NormalizeUnicode, with non-alphanumeric
+// characters ignored when opts.Ignore is nil. The result is split into
+// grapheme clusters so that combining marks that are kept stay with their
+// base character when the order is reversed.
+func palindromeChars(s string, opts NormalizeOptions) ([]string, error) {
+	if !opts.CaseFold {
+		s = strings.ToLower(s)
+	}
+	if opts.Ignore == nil {
+		opts.Ignore = []string{"non_alphanumeric"}
+	}
+	normalized, err := NormalizeUnicode(s, opts)
+	if err != nil {
+		return nil, err
+	}
+	return splitString(normalized, unitGraphemes), nil
+}
+
+// String distance algorithms accepted by StringDistance.
+const (
+	distanceLevenshtein        = "levenshtein"
+	distanceDamerauLevenshtein = "damerau_levenshtein"
+	distanceJaroWinkler        = "jaro_winkler"
+	distanceHamming            = "hamming"
+)
+
+// StringDistance measures how different a and b are, comparing runes. For
+// levenshtein, damerau_levenshtein and hamming the result is a number of
+// edits; for jaro_winkler it is 1 minus the Jaro-Winkler similarity, from 0
+// for equal strings to 1 for strings with nothing in common.
+func StringDistance(a, b, algorithm string) (float64, error) {
+	ra, rb := []rune(a), []rune(b)
+	switch algorithm {
+	case distanceLevenshtein:
+		return float64(levenshtein(ra, rb)), nil
+	case distanceDamerauLevenshtein:
+		return float64(damerauLevenshtein(ra, rb)), nil
+	case distanceJaroWinkler:
+		return 1 - jaroWinkler(ra, rb), nil
+	case distanceHamming:
+		if len(ra) != len(rb) {
+			return 0, fmt.Errorf("hamming distance requires strings of equal length, got %d and %d characters", len(ra), len(rb))
+		}
+		n := 0
+		for i := range ra {
+			if ra[i] != rb[i] {
+				n++
+			}
+		}
+		return float64(n), nil
+	default:
+		return 0, fmt.Errorf("unsupported algorithm %q, expected one of: %s, %s, %s, %s",
+			algorithm, distanceLevenshtein, distanceDamerauLevenshtein, distanceJaroWinkler, distanceHamming)
+	}
+}
+
+// ClosestMatch returns the candidate with the smallest case-insensitive
+// Damerau-Levenshtein distance to s, provided it is at most maxDistance.
+// Ties go to the earlier candidate. ok is false if no candidate is close
+// enough.
+func ClosestMatch(s string, candidates []string, maxDistance int) (match string, ok bool) {
+	input := []rune(strings.ToLower(s))
+	best := 0
+	for _, c := range candidates {
+		d := damerauLevenshtein(input, []rune(strings.ToLower(c)))
+		if d <= maxDistance && (!ok || d < best) {
+			match, best, ok = c, d, true
+		}
+	}
+	return match, ok
+}
+
+// levenshtein counts the insertions, deletions and substitutions needed to
+// turn a into b, keeping only two rows of the edit matrix.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// damerauLevenshtein is levenshtein that also counts a transposition of
+// adjacent characters as one edit. Unlike the restricted optimal string
+// alignment variant, substrings may be edited again after a transposition,
+// so "ca" to "abc" takes 2 edits rather than 3.
+func damerauLevenshtein(a, b []rune) int {
+	// d is offset by one row and column to hold the sentinel maxDist.
+	maxDist := len(a) + len(b)
+	d := make([][]int, len(a)+2)
+	for i := range d {
+		d[i] = make([]int, len(b)+2)
+	}
+	d[0][0] = maxDist
+	for i := 0; i <= len(a); i++ {
+		d[i+1][0] = maxDist
+		d[i+1][1] = i
+	}
+	for j := 0; j <= len(b); j++ {
+		d[0][j+1] = maxDist
+		d[1][j+1] = j
+	}
+
+	lastRow := map[rune]int{}
+	for i := 1; i <= len(a); i++ {
+		lastMatchCol := 0
+		for j := 1; j <= len(b); j++ {
+			k := lastRow[b[j-1]]
+			l := lastMatchCol
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastMatchCol = j
+			}
+			d[i+1][j+1] = min(
+				d[i][j]+cost,
+				d[i+1][j]+1,
+				d[i][j+1]+1,
+				d[k][l]+(i-k-1)+1+(j-l-1),
+			)
+		}
+		lastRow[a[i-1]] = i
+	}
+	return d[len(a)+1][len(b)+1]
+}
+
+// jaroWinkler returns the Jaro similarity of a and b, boosted by 0.1 for
+// each of up to four leading characters they share.
+func jaroWinkler(a, b []rune) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	window := max(len(a), len(b))/2 - 1
+	window = max(window, 0)
+	matchedA := make([]bool, len(a))
+	matchedB := make([]bool, len(b))
+	matches := 0
+	for i := range a {
+		for j := max(0, i-window); j < min(len(b), i+window+1); j++ {
+			if !matchedB[j] && a[i] == b[j] {
+				matchedA[i], matchedB[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	transpositions, j := 0, 0
+	for i := range a {
+		if !matchedA[i] {
+			continue
+		}
+		for !matchedB[j] {
+			j++
+		}
+		if a[i] != b[j] {
+			transpositions++
 		}
+		j++
+	}
+
+	m := float64(matches)
+	jaro := (m/float64(len(a)) + m/float64(len(b)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(a), len(b)) && a[prefix] == b[prefix] {
+		prefix++
 	}
-	return true
+	return jaro + float64(prefix)*0.1*(1-jaro)
 }
@@ -37,6 +37,44 @@ func TestIsPalindrome(t *testing.T) {
 	}
 }
 
+func TestIsPalindromeWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    NormalizeOptions
+		want    bool
+		wantErr bool
+	}{
+		{name: "combining accents ignored by default", input: "E\u0301se\u0301", want: true},
+		{name: "mixed composition with marks kept", input: "\u00e9te\u0301", opts: NormalizeOptions{Ignore: []string{}}, want: false},
+		{name: "nfc matches mixed composition", input: "\u00e9te\u0301", opts: NormalizeOptions{Form: "NFC", Ignore: []string{}}, want: true},
+		{name: "marks kept with their base", input: "e\u0301te\u0301", opts: NormalizeOptions{Form: "NFD", Ignore: []string{}}, want: true},
+		{name: "accents differ", input: "ésa", opts: NormalizeOptions{Ignore: []string{}}, want: false},
+		{name: "fold accents", input: "Ésé", opts: NormalizeOptions{FoldAccents: true, Ignore: []string{}}, want: true},
+		{name: "full-width without normalization", input: "ａba", want: false},
+		{name: "nfkc full-width", input: "ａba", opts: NormalizeOptions{Form: "NFKC"}, want: true},
+		{name: "lowercasing keeps sharp s", input: "ßs", want: false},
+		{name: "case folding expands sharp s", input: "Sß", opts: NormalizeOptions{CaseFold: true}, want: true},
+		{name: "ignore whitespace only", input: "taco cat!", opts: NormalizeOptions{Ignore: []string{"whitespace"}}, want: false},
+		{name: "ignore whitespace and punctuation", input: "taco cat!", opts: NormalizeOptions{Ignore: []string{"whitespace", "punctuation"}}, want: true},
+		{name: "ignore digits", input: "a1b2a", opts: NormalizeOptions{Ignore: []string{"digits"}}, want: true},
+		{name: "unknown class", input: "x", opts: NormalizeOptions{Ignore: []string{"vowels"}}, wantErr: true},
+		{name: "unknown form", input: "x", opts: NormalizeOptions{Form: "NFX"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsPalindromeWithOptions(tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IsPalindromeWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("IsPalindromeWithOptions(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsPalindromeFunction_Run(t *testing.T) {
 	f := NewIsPalindromeFunction()
 
@@ -56,6 +94,7 @@ func TestIsPalindromeFunction_Run(t *testing.T) {
 			req := function.RunRequest{
 				Arguments: function.NewArgumentsData([]attr.Value{
 					types.StringValue(tt.input),
+					types.TupleValueMust([]attr.Type{}, []attr.Value{}),
 				}),
 			}
 			resp := function.RunResponse{
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*k8sLabelKeyFunction)(nil)
+
+type k8sLabelKeyFunction struct{}
+
+func NewK8sLabelKeyFunction() function.Function {
+	return &k8sLabelKeyFunction{}
+}
+
+func (f *k8sLabelKeyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_label_key"
+}
+
+func (f *k8sLabelKeyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a valid Kubernetes label key from an optional prefix and a name",
+		Description: "The prefix is lowercased and sanitized as a DNS subdomain of at most 253 characters; an empty prefix is omitted. " +
+			"The name is sanitized as k8s_label_value does and must not end up empty. Long parts are shortened with a hash suffix as truncate does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "prefix",
+				Description: "The DNS subdomain prefix, e.g. example.com, or an empty string for none",
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "The name part of the key",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *k8sLabelKeyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &prefix, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := K8sLabelKey(prefix, name)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
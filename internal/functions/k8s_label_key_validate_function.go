@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*k8sLabelKeyValidateFunction)(nil)
+
+type k8sLabelKeyValidateFunction struct{}
+
+func NewK8sLabelKeyValidateFunction() function.Function {
+	return &k8sLabelKeyValidateFunction{}
+}
+
+func (f *k8sLabelKeyValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_label_key_validate"
+}
+
+func (f *k8sLabelKeyValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Explains why a string is not a valid Kubernetes label key",
+		Description: "Returns one message per violated rule, or an empty list if the key is valid. " +
+			"A key is an optional DNS subdomain prefix of at most 253 characters, with parts of at most 63, followed by \"/\", and a non-empty name that follows the label value rules.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key",
+				Description: "The label key to check, e.g. app.kubernetes.io/name",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *k8sLabelKeyValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key))
+	if resp.Error != nil {
+		return
+	}
+
+	problems := ValidateK8sLabelKey(key)
+	if problems == nil {
+		problems = []string{}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, problems))
+}
@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*k8sLabelValueFunction)(nil)
+
+type k8sLabelValueFunction struct{}
+
+func NewK8sLabelValueFunction() function.Function {
+	return &k8sLabelValueFunction{}
+}
+
+func (f *k8sLabelValueFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_label_value"
+}
+
+func (f *k8sLabelValueFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a string into a valid Kubernetes label value",
+		Description: "Characters other than letters, digits, \"-\", \"_\" and \".\" are replaced with \"-\", and non-alphanumeric characters are trimmed from both ends. " +
+			"Values longer than 63 characters are shortened with a hash suffix as truncate does, so distinct inputs stay distinct.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *k8sLabelValueFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := K8sLabelValue(input)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
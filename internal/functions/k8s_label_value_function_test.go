@@ -0,0 +1,36 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sLabelValueFunction_Run(t *testing.T) {
+	f := NewK8sLabelValueFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("team: payments"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "team-payments")
+}
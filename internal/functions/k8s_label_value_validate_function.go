@@ -0,0 +1,50 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*k8sLabelValueValidateFunction)(nil)
+
+type k8sLabelValueValidateFunction struct{}
+
+func NewK8sLabelValueValidateFunction() function.Function {
+	return &k8sLabelValueValidateFunction{}
+}
+
+func (f *k8sLabelValueValidateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_label_value_validate"
+}
+
+func (f *k8sLabelValueValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Explains why a string is not a valid Kubernetes label value",
+		Description: "Returns one message per violated rule, or an empty list if the value is valid. " +
+			"Label values are at most 63 characters, contain only letters, digits, \"-\", \"_\" and \".\", and start and end with a letter or digit unless empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "value",
+				Description: "The label value to check",
+			},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *k8sLabelValueValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	problems := ValidateK8sLabelValue(value)
+	if problems == nil {
+		problems = []string{}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, problems))
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestK8sLabelValueValidateFunction_Run(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  int
+	}{
+		{name: "valid", value: "payments", want: 0},
+		{name: "invalid", value: "-team payments", want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewK8sLabelValueValidateFunction()
+			ctx := context.Background()
+
+			result := function.NewResultData(basetypes.NewListNull(types.StringType))
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.value),
+				}),
+			}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(ctx, req, &resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			got, ok := resp.Result.Value().(basetypes.ListValue)
+			if !ok {
+				t.Fatalf("result is not ListValue, got %T", resp.Result.Value())
+			}
+			if got.IsNull() {
+				t.Fatal("result is null, want a list")
+			}
+			if len(got.Elements()) != tt.want {
+				t.Errorf("got %d problems, want %d: %v", len(got.Elements()), tt.want, got)
+			}
+		})
+	}
+}
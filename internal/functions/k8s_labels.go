@@ -0,0 +1,147 @@
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	k8sLabelValueMaxLength  = 63
+	k8sLabelPrefixMaxLength = 253
+)
+
+// k8sLabelValueRule sanitizes label values and the name part of label keys.
+// Values may be empty; key names are checked separately.
+var k8sLabelValueRule = nameRule{
+	MaxLength: k8sLabelValueMaxLength, Punctuation: "-_.", Separator: "-",
+	EdgeAlphanumeric: true,
+}
+
+var (
+	k8sLabelValueRegexp  = regexp.MustCompile(`^[-_.A-Za-z0-9]*$`)
+	k8sLabelPrefixRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// K8sLabelValue converts s into a valid Kubernetes label value. Invalid
+// characters are replaced with "-", non-alphanumeric characters are trimmed
+// from both ends, and values longer than 63 characters are shortened with a
+// hash suffix as Truncate does, so distinct long inputs stay distinct.
+func K8sLabelValue(s string) (string, error) {
+	return k8sLabelValueRule.build([]string{s})
+}
+
+// K8sLabelKey builds a Kubernetes label key from an optional DNS subdomain
+// prefix and a name. The prefix is lowercased and each of its dot-separated
+// labels is sanitized as a DNS label; the name is sanitized as a label value
+// and must not end up empty.
+func K8sLabelKey(prefix, name string) (string, error) {
+	n, err := K8sLabelValue(name)
+	if err != nil {
+		return "", err
+	}
+	if n == "" {
+		return "", fmt.Errorf("name %q contains no valid characters", name)
+	}
+	if prefix == "" {
+		return n, nil
+	}
+
+	p, err := k8sLabelPrefix(prefix)
+	if err != nil {
+		return "", err
+	}
+	if p == "" {
+		return "", fmt.Errorf("prefix %q contains no valid characters", prefix)
+	}
+	return p + "/" + n, nil
+}
+
+func k8sLabelPrefix(prefix string) (string, error) {
+	labelRule := resourceNameRules["k8s_dns_label"]
+	labelRule.MinLength = 0
+
+	var labels []string
+	for _, l := range strings.Split(prefix, ".") {
+		cleaned, err := labelRule.build([]string{l})
+		if err != nil {
+			return "", err
+		}
+		if cleaned != "" {
+			labels = append(labels, cleaned)
+		}
+	}
+
+	p := strings.Join(labels, ".")
+	if len(p) <= k8sLabelPrefixMaxLength {
+		return p, nil
+	}
+	// Put the hash suffix in a label of its own so the last label does not
+	// grow past 63 characters. The cut may leave a "-" or "." at the end of
+	// the text, which is trimmed rather than collapsed into the separator,
+	// as that would join the hash to the last label.
+	opts := DefaultTruncateOptions()
+	opts.Separator = "."
+	truncated, err := TruncateWithOptions(p, k8sLabelPrefixMaxLength, opts)
+	if err != nil {
+		return "", err
+	}
+	i := strings.LastIndexByte(truncated, '.')
+	return strings.TrimRight(truncated[:i], "-.") + truncated[i:], nil
+}
+
+// ValidateK8sLabelValue returns the reasons s is not a valid Kubernetes
+// label value, or nil if it is valid.
+func ValidateK8sLabelValue(s string) []string {
+	return validateK8sLabelValue("value", s)
+}
+
+// ValidateK8sLabelKey returns the reasons key is not a valid Kubernetes
+// label key, or nil if it is valid.
+func ValidateK8sLabelKey(key string) []string {
+	var problems []string
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		name = rest
+		switch {
+		case prefix == "":
+			problems = append(problems, "prefix must not be empty when a \"/\" is present")
+		case len(prefix) > k8sLabelPrefixMaxLength:
+			problems = append(problems, fmt.Sprintf("prefix must be no more than %d characters, got %d", k8sLabelPrefixMaxLength, len(prefix)))
+		case !k8sLabelPrefixRegexp.MatchString(prefix):
+			problems = append(problems, fmt.Sprintf("prefix %q must be a DNS subdomain: lowercase alphanumeric characters, \"-\" or \".\", with each dot-separated part starting and ending with an alphanumeric character", prefix))
+		}
+		for _, label := range strings.Split(prefix, ".") {
+			if len(label) > k8sLabelValueMaxLength {
+				problems = append(problems, fmt.Sprintf("prefix part %q must be no more than %d characters, got %d", label, k8sLabelValueMaxLength, len(label)))
+			}
+		}
+		if strings.Contains(rest, "/") {
+			problems = append(problems, "key must contain at most one \"/\", separating the prefix from the name")
+			return problems
+		}
+	}
+
+	if name == "" {
+		return append(problems, "name must not be empty")
+	}
+	return append(problems, validateK8sLabelValue("name", name)...)
+}
+
+func validateK8sLabelValue(what, s string) []string {
+	var problems []string
+	if len(s) > k8sLabelValueMaxLength {
+		problems = append(problems, fmt.Sprintf("%s must be no more than %d characters, got %d", what, k8sLabelValueMaxLength, len(s)))
+	}
+	if !k8sLabelValueRegexp.MatchString(s) {
+		problems = append(problems, fmt.Sprintf("%s %q must contain only alphanumeric characters, \"-\", \"_\" or \".\"", what, s))
+	}
+	if s != "" && (!isASCIIAlphanumeric(s[0]) || !isASCIIAlphanumeric(s[len(s)-1])) {
+		problems = append(problems, fmt.Sprintf("%s %q must start and end with an alphanumeric character", what, s))
+	}
+	return problems
+}
+
+func isASCIIAlphanumeric(c byte) bool {
+	return isASCIILetter(c) || isASCIIDigit(c)
+}
@@ -0,0 +1,204 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestK8sLabelValue(t *testing.T) {
+	long := strings.Repeat("a", 70)
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "valid", input: "payments-api_v1.2", want: "payments-api_v1.2"},
+		{name: "keeps case", input: "PaymentsAPI", want: "PaymentsAPI"},
+		{name: "replaces invalid characters", input: "team: payments/api", want: "team-payments-api"},
+		{name: "trims edges", input: "--payments.", want: "payments"},
+		{name: "non-ascii", input: "Zürich", want: "Z-rich"},
+		{name: "empty", input: "", want: ""},
+		{name: "nothing valid", input: "!!!", want: ""},
+		{name: "long", input: long, want: mustTruncate(t, long, 63)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := K8sLabelValue(tt.input)
+			if err != nil {
+				t.Fatalf("K8sLabelValue() error = %v", err)
+			}
+			assertEqual(t, got, tt.want)
+			if problems := ValidateK8sLabelValue(got); problems != nil {
+				t.Errorf("K8sLabelValue(%q) = %q, which is invalid: %v", tt.input, got, problems)
+			}
+		})
+	}
+}
+
+func TestK8sLabelValue_Distinct(t *testing.T) {
+	a, _ := K8sLabelValue(strings.Repeat("x", 70) + "a")
+	b, _ := K8sLabelValue(strings.Repeat("x", 70) + "b")
+	if a == b {
+		t.Errorf("distinct inputs both shortened to %q", a)
+	}
+	if len(a) != 63 {
+		t.Errorf("len(%q) = %d, want 63", a, len(a))
+	}
+}
+
+func TestK8sLabelKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		prefix  string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "no prefix", input: "app", want: "app"},
+		{name: "prefix", prefix: "app.kubernetes.io", input: "name", want: "app.kubernetes.io/name"},
+		{name: "prefix lowercased", prefix: "Example.COM", input: "Team", want: "example.com/Team"},
+		{name: "prefix labels sanitized", prefix: "-my_team..example.com-", input: "owner", want: "my-team.example.com/owner"},
+		{name: "name sanitized", prefix: "example.com", input: "cost center!", want: "example.com/cost-center"},
+		{name: "empty name", prefix: "example.com", input: "***", wantErr: true},
+		{name: "empty prefix after cleaning", prefix: "...", input: "x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := K8sLabelKey(tt.prefix, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("K8sLabelKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestK8sLabelKey_LongPrefix(t *testing.T) {
+	labels := make([]string, 8)
+	for i := range labels {
+		labels[i] = strings.Repeat(string(rune('a'+i)), 40)
+	}
+	got, err := K8sLabelKey(strings.Join(labels, "."), "name")
+	if err != nil {
+		t.Fatalf("K8sLabelKey() error = %v", err)
+	}
+	if problems := ValidateK8sLabelKey(got); problems != nil {
+		t.Errorf("K8sLabelKey() = %q, which is invalid: %v", got, problems)
+	}
+}
+
+func TestK8sLabelKey_LongPrefixCutAtHyphen(t *testing.T) {
+	// The cut falls just after the "-" of the fourth label, which must not
+	// swallow the "." before the hash and join it to that label.
+	prefix := strings.Join([]string{
+		strings.Repeat("a", 62),
+		strings.Repeat("a", 62),
+		strings.Repeat("a", 60),
+		strings.Repeat("a", 56) + "-" + strings.Repeat("a", 6),
+		"bbb",
+	}, ".")
+	got, err := K8sLabelKey(prefix, "name")
+	if err != nil {
+		t.Fatalf("K8sLabelKey() error = %v", err)
+	}
+	if problems := ValidateK8sLabelKey(got); problems != nil {
+		t.Errorf("K8sLabelKey() = %q, which is invalid: %v", got, problems)
+	}
+	p, _, _ := strings.Cut(got, "/")
+	labels := strings.Split(p, ".")
+	assertEqual(t, labels[len(labels)-2], strings.Repeat("a", 56))
+	if hash := labels[len(labels)-1]; len(hash) != 8 {
+		t.Errorf("last label = %q, want the 8 character hash", hash)
+	}
+}
+
+func TestValidateK8sLabelValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "valid", input: "payments-api_v1.2"},
+		{name: "empty is valid", input: ""},
+		{
+			name:  "too long",
+			input: strings.Repeat("a", 64),
+			want:  []string{"value must be no more than 63 characters, got 64"},
+		},
+		{
+			name:  "invalid characters",
+			input: "a b",
+			want:  []string{`value "a b" must contain only alphanumeric characters, "-", "_" or "."`},
+		},
+		{
+			name:  "edges",
+			input: "-ab_",
+			want:  []string{`value "-ab_" must start and end with an alphanumeric character`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateK8sLabelValue(tt.input)
+			assertEqual(t, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		})
+	}
+}
+
+func TestValidateK8sLabelKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "valid", input: "app.kubernetes.io/name"},
+		{name: "valid without prefix", input: "tier"},
+		{name: "empty", input: "", want: []string{"name must not be empty"}},
+		{name: "empty prefix", input: "/name", want: []string{`prefix must not be empty when a "/" is present`}},
+		{
+			name:  "uppercase prefix",
+			input: "Example.com/name",
+			want:  []string{`prefix "Example.com" must be a DNS subdomain: lowercase alphanumeric characters, "-" or ".", with each dot-separated part starting and ending with an alphanumeric character`},
+		},
+		{
+			name:  "prefix part too long",
+			input: "example." + strings.Repeat("a", 64) + "/name",
+			want:  []string{fmt.Sprintf("prefix part %q must be no more than 63 characters, got 64", strings.Repeat("a", 64))},
+		},
+		{
+			name:  "too many slashes",
+			input: "example.com/a/b",
+			want:  []string{`key must contain at most one "/", separating the prefix from the name`},
+		},
+		{
+			name:  "prefix and name problems",
+			input: "example..com/_name",
+			want: []string{
+				`prefix "example..com" must be a DNS subdomain: lowercase alphanumeric characters, "-" or ".", with each dot-separated part starting and ending with an alphanumeric character`,
+				`name "_name" must start and end with an alphanumeric character`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateK8sLabelKey(tt.input)
+			assertEqual(t, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		})
+	}
+}
+
+func mustTruncate(t *testing.T, s string, n int) string {
+	t.Helper()
+	got, err := Truncate(s, n)
+	if err != nil {
+		t.Fatalf("Truncate() error = %v", err)
+	}
+	return got
+}
@@ -2,9 +2,12 @@ package functions
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ function.Function = (*maskFunction)(nil)
@@ -22,6 +25,11 @@ func (f *maskFunction) Metadata(_ context.Context, _ function.MetadataRequest, r
 func (f *maskFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary: "Masks a string, revealing only the last N characters",
+		Description: "Options: show_first (default 0) also reveals leading characters; mask_char (default \"*\") may be any single character; " +
+			"show_last, if given, replaces the positional argument; " +
+			"fixed_length, when positive, replaces the hidden part with exactly that many mask characters so the result does not reveal the input's length, and is the whole result when nothing would otherwise be hidden; " +
+			"min_masked masks the whole input when fewer than that many characters would otherwise be hidden; " +
+			"unit (runes or graphemes, default runes) selects what counts as a character. With graphemes, user-perceived characters such as emoji sequences and flags are never split.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
@@ -32,6 +40,10 @@ func (f *maskFunction) Definition(_ context.Context, _ function.DefinitionReques
 				Description: "The number of trailing characters to leave visible",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with show_first, show_last, mask_char, fixed_length, min_masked and unit attributes",
+		},
 		Return: function.StringReturn{},
 	}
 }
@@ -39,20 +51,157 @@ func (f *maskFunction) Definition(_ context.Context, _ function.DefinitionReques
 func (f *maskFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
 	var showLast int64
-	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &showLast))
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &showLast, &optionArgs))
 	if resp.Error != nil {
 		return
 	}
 
-	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, Mask(input, int(showLast))))
+	if len(optionArgs) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, Mask(input, int(showLast))))
+		return
+	}
+
+	opts, err := parseMaskOptions(optionArgs, int(showLast))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := MaskWithOptions(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// MaskOptions controls MaskWithOptions.
+type MaskOptions struct {
+	// ShowFirst is the number of leading characters left visible.
+	ShowFirst int
+	// ShowLast is the number of trailing characters left visible.
+	ShowLast int
+	// MaskChar replaces hidden characters.
+	MaskChar rune
+	// FixedLength, when positive, replaces the hidden part with exactly that
+	// many mask characters, hiding the length of the input. If nothing would
+	// be hidden the result is just the mask characters, so that a short
+	// input is not revealed.
+	FixedLength int
+	// MinMasked masks the whole input when fewer than MinMasked characters
+	// would otherwise be hidden, so short secrets are never mostly revealed.
+	MinMasked int
+	// Unit is what counts as a character: "runes" (the default) or
+	// "graphemes", so that an emoji sequence or a flag is shown or hidden
+	// as a whole and replaced by a single mask character.
+	Unit string
+}
+
+// DefaultMaskOptions returns the options used by Mask, apart from ShowLast.
+func DefaultMaskOptions() MaskOptions {
+	return MaskOptions{MaskChar: '*', Unit: unitRunes}
+}
+
+// parseMaskOptions reads the options of mask. A show_last option takes
+// precedence over the positional showLast.
+func parseMaskOptions(args []types.Dynamic, showLast int) (MaskOptions, error) {
+	opts, err := parseOptions(args, "show_first", "show_last", "mask_char", "fixed_length", "min_masked", "unit")
+	if err != nil {
+		return MaskOptions{}, err
+	}
+	result, err := maskOptionsFrom(opts)
+	if err != nil {
+		return MaskOptions{}, err
+	}
+	if result.ShowLast, err = opts.Int("show_last", showLast); err != nil {
+		return MaskOptions{}, err
+	}
+	return result, nil
+}
+
+// maskOptionsFrom reads the mask options shared by mask and redact_secrets.
+// show_last is read only if it was allowed when opts was parsed.
+func maskOptionsFrom(opts functionOptions) (MaskOptions, error) {
+	result := DefaultMaskOptions()
+	var err error
+	if result.ShowFirst, err = opts.Int("show_first", result.ShowFirst); err != nil {
+		return MaskOptions{}, err
+	}
+	if result.ShowLast, err = opts.Int("show_last", result.ShowLast); err != nil {
+		return MaskOptions{}, err
+	}
+	maskChar, err := opts.String("mask_char", string(result.MaskChar))
+	if err != nil {
+		return MaskOptions{}, err
+	}
+	if utf8.RuneCountInString(maskChar) != 1 {
+		return MaskOptions{}, fmt.Errorf("option \"mask_char\" must be a single character, got %q", maskChar)
+	}
+	result.MaskChar, _ = utf8.DecodeRuneInString(maskChar)
+	if result.FixedLength, err = opts.Int("fixed_length", result.FixedLength); err != nil {
+		return MaskOptions{}, err
+	}
+	if result.MinMasked, err = opts.Int("min_masked", result.MinMasked); err != nil {
+		return MaskOptions{}, err
+	}
+	if result.Unit, err = opts.String("unit", result.Unit); err != nil {
+		return MaskOptions{}, err
+	}
+	return result, nil
 }
 
-// Mask replaces all but the last showLast characters with asterisks.
+// Mask replaces all but the last showLast characters with asterisks. A
+// negative showLast leaves s unchanged.
 func Mask(s string, showLast int) string {
-	runes := []rune(s)
-	if showLast >= len(runes) || showLast < 0 {
+	if showLast < 0 {
 		return s
 	}
-	masked := len(runes) - showLast
-	return strings.Repeat("*", masked) + string(runes[masked:])
+	opts := DefaultMaskOptions()
+	opts.ShowLast = showLast
+	masked, _ := MaskWithOptions(s, opts)
+	return masked
+}
+
+// MaskWithOptions replaces the characters of s between the first ShowFirst
+// and the last ShowLast with MaskChar. If nothing would be hidden the input
+// is returned as is, unless MinMasked forces it to be masked entirely or
+// FixedLength is set, in which case only the mask characters are returned.
+func MaskWithOptions(s string, opts MaskOptions) (string, error) {
+	if opts.ShowFirst < 0 {
+		return "", fmt.Errorf("show_first must not be negative, got %d", opts.ShowFirst)
+	}
+	if opts.ShowLast < 0 {
+		return "", fmt.Errorf("show_last must not be negative, got %d", opts.ShowLast)
+	}
+	if opts.FixedLength < 0 {
+		return "", fmt.Errorf("fixed_length must not be negative, got %d", opts.FixedLength)
+	}
+	if opts.MinMasked < 0 {
+		return "", fmt.Errorf("min_masked must not be negative, got %d", opts.MinMasked)
+	}
+	unit := defaultString(opts.Unit, unitRunes)
+	if unit != unitRunes && unit != unitGraphemes {
+		return "", fmt.Errorf("unsupported unit %q, expected one of: runes, graphemes", unit)
+	}
+
+	chars := splitString(s, unit)
+	first, last := opts.ShowFirst, opts.ShowLast
+	hidden := len(chars) - first - last
+	if opts.MinMasked > 0 && hidden < opts.MinMasked {
+		first, last, hidden = 0, 0, len(chars)
+	}
+	if hidden <= 0 {
+		if opts.FixedLength > 0 {
+			return strings.Repeat(string(opts.MaskChar), opts.FixedLength), nil
+		}
+		return s, nil
+	}
+
+	n := hidden
+	if opts.FixedLength > 0 {
+		n = opts.FixedLength
+	}
+	return strings.Join(chars[:first], "") + strings.Repeat(string(opts.MaskChar), n) + strings.Join(chars[len(chars)-last:], ""), nil
 }
@@ -35,6 +35,62 @@ func TestMask(t *testing.T) {
 	}
 }
 
+func TestMaskWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		modify  func(o *MaskOptions)
+		want    string
+		wantErr bool
+	}{
+		{name: "defaults mask everything", input: "secret", want: "******"},
+		{name: "show first and last", input: "4111111111111111", modify: func(o *MaskOptions) { o.ShowFirst = 4; o.ShowLast = 4 }, want: "4111********1111"},
+		{name: "show first only", input: "hunter2", modify: func(o *MaskOptions) { o.ShowFirst = 2 }, want: "hu*****"},
+		{name: "custom mask char", input: "secret", modify: func(o *MaskOptions) { o.MaskChar = '•'; o.ShowLast = 2 }, want: "••••et"},
+		{name: "fixed length longer", input: "abc", modify: func(o *MaskOptions) { o.FixedLength = 8 }, want: "********"},
+		{name: "fixed length shorter", input: "sk-1234567890abcdef", modify: func(o *MaskOptions) { o.ShowLast = 4; o.FixedLength = 6 }, want: "******cdef"},
+		{name: "nothing hidden", input: "abcd", modify: func(o *MaskOptions) { o.ShowFirst = 2; o.ShowLast = 2 }, want: "abcd"},
+		{name: "nothing hidden with fixed length", input: "ab", modify: func(o *MaskOptions) { o.ShowLast = 4; o.FixedLength = 8 }, want: "********"},
+		{name: "min masked forces full mask", input: "abcd", modify: func(o *MaskOptions) { o.ShowLast = 3; o.MinMasked = 2 }, want: "****"},
+		{name: "min masked satisfied", input: "abcdef", modify: func(o *MaskOptions) { o.ShowLast = 3; o.MinMasked = 2 }, want: "***def"},
+		{name: "min masked with fixed length", input: "ab", modify: func(o *MaskOptions) { o.ShowLast = 2; o.MinMasked = 4; o.FixedLength = 6 }, want: "******"},
+		{name: "unicode", input: "日本語テキスト", modify: func(o *MaskOptions) { o.ShowFirst = 1; o.ShowLast = 1 }, want: "日*****ト"},
+		{name: "empty", input: "", modify: func(o *MaskOptions) { o.MinMasked = 4 }, want: ""},
+		{name: "runes split emoji sequences", input: "ab\U0001F468\u200D\U0001F469", modify: func(o *MaskOptions) { o.ShowLast = 1 }, want: "****\U0001F469"},
+		{
+			name:   "graphemes keep emoji sequences",
+			input:  "ab\U0001F468\u200D\U0001F469",
+			modify: func(o *MaskOptions) { o.ShowLast = 1; o.Unit = unitGraphemes },
+			want:   "**\U0001F468\u200D\U0001F469",
+		},
+		{
+			name:   "graphemes mask flags whole",
+			input:  "\U0001F1E9\U0001F1EA\U0001F1EB\U0001F1F7",
+			modify: func(o *MaskOptions) { o.ShowFirst = 1; o.Unit = unitGraphemes },
+			want:   "\U0001F1E9\U0001F1EA*",
+		},
+		{name: "unsupported unit", input: "x", modify: func(o *MaskOptions) { o.Unit = unitBytes }, wantErr: true},
+		{name: "negative show first", input: "x", modify: func(o *MaskOptions) { o.ShowFirst = -1 }, wantErr: true},
+		{name: "negative fixed length", input: "x", modify: func(o *MaskOptions) { o.FixedLength = -1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultMaskOptions()
+			if tt.modify != nil {
+				tt.modify(&opts)
+			}
+			got, err := MaskWithOptions(tt.input, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MaskWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMaskFunction_Run(t *testing.T) {
 	f := NewMaskFunction()
 	ctx := context.Background()
@@ -44,6 +100,7 @@ func TestMaskFunction_Run(t *testing.T) {
 		Arguments: function.NewArgumentsData([]attr.Value{
 			types.StringValue("sk-1234567890abcdef"),
 			types.Int64Value(4),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
 		}),
 	}
 	resp := function.RunResponse{Result: result}
@@ -62,3 +119,100 @@ func TestMaskFunction_Run(t *testing.T) {
 		t.Errorf("mask result = %q, want %q", got.ValueString(), "***************cdef")
 	}
 }
+
+func TestMaskFunction_RunNegativeShowLastWithOptions(t *testing.T) {
+	f := NewMaskFunction()
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("secret"),
+			types.Int64Value(-1),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"mask_char": types.StringType},
+					map[string]attr.Value{"mask_char": types.StringValue("#")},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error == nil {
+		t.Fatalf("expected an error, got %v", resp.Result.Value())
+	}
+}
+
+func TestMaskFunction_RunWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		options map[string]attr.Value
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "show first and mask char",
+			options: map[string]attr.Value{"show_first": types.NumberValue(bigFloat(3)), "mask_char": types.StringValue("#")},
+			want:    "sk-############cdef",
+		},
+		{
+			name:    "fixed length",
+			options: map[string]attr.Value{"fixed_length": types.NumberValue(bigFloat(4))},
+			want:    "****cdef",
+		},
+		{
+			name:    "multi-character mask char",
+			options: map[string]attr.Value{"mask_char": types.StringValue("ab")},
+			wantErr: true,
+		},
+		{
+			name:    "show_last overrides positional",
+			options: map[string]attr.Value{"show_last": types.NumberValue(bigFloat(2))},
+			want:    "*****************ef",
+		},
+		{
+			name:    "negative show_last",
+			options: map[string]attr.Value{"show_last": types.NumberValue(bigFloat(-1))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewMaskFunction()
+			ctx := context.Background()
+
+			attrTypes := make(map[string]attr.Type, len(tt.options))
+			for k, v := range tt.options {
+				attrTypes[k] = v.Type(ctx)
+			}
+
+			result := function.NewResultData(basetypes.NewStringNull())
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue("sk-1234567890abcdef"),
+					types.Int64Value(4),
+					types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+						types.DynamicValue(types.ObjectValueMust(attrTypes, tt.options)),
+					}),
+				}),
+			}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(ctx, req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("unexpected error state: %v", resp.Error)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+			}
+			assertEqual(t, got.ValueString(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+var _ function.Function = (*normalizeUnicodeFunction)(nil)
+
+type normalizeUnicodeFunction struct{}
+
+func NewNormalizeUnicodeFunction() function.Function {
+	return &normalizeUnicodeFunction{}
+}
+
+func (f *normalizeUnicodeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_unicode"
+}
+
+func (f *normalizeUnicodeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a string to a Unicode normalization form, optionally folding case and accents",
+		Description: "This is the normalization applied by is_palindrome. Options: case_fold applies full Unicode case folding, so ß becomes ss; fold_accents removes accents and other combining marks; " +
+			"ignore is a list of character classes to remove: " + strings.Join(unicodeClassNames(), ", ") + ".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to normalize",
+			},
+			function.StringParameter{
+				Name:        "form",
+				Description: "The normalization form: NFC, NFD, NFKC or NFKD",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with case_fold, fold_accents and ignore attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *normalizeUnicodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, form string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &form, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	if form == "" {
+		resp.Error = function.NewArgumentFuncError(1, "form must not be empty, expected one of: NFC, NFD, NFKC, NFKD")
+		return
+	}
+
+	opts, err := parseNormalizeOptions(optionArgs, "case_fold", "fold_accents", "ignore")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	opts.Form = form
+
+	result, err := NormalizeUnicode(input, opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// NormalizeOptions controls NormalizeUnicode.
+type NormalizeOptions struct {
+	// Form is "NFC", "NFD", "NFKC", "NFKD" or empty for no normalization.
+	// It is matched case-insensitively.
+	Form string
+	// CaseFold applies full Unicode case folding.
+	CaseFold bool
+	// FoldAccents removes nonspacing combining marks after canonical
+	// decomposition, so "é" becomes "e".
+	FoldAccents bool
+	// Ignore lists the character classes removed from the result.
+	Ignore []string
+}
+
+func parseNormalizeOptions(args []types.Dynamic, allowed ...string) (NormalizeOptions, error) {
+	opts, err := parseOptions(args, allowed...)
+	if err != nil {
+		return NormalizeOptions{}, err
+	}
+
+	var result NormalizeOptions
+	if result.Form, err = opts.String("normalize", result.Form); err != nil {
+		return NormalizeOptions{}, err
+	}
+	if result.CaseFold, err = opts.Bool("case_fold", result.CaseFold); err != nil {
+		return NormalizeOptions{}, err
+	}
+	if result.FoldAccents, err = opts.Bool("fold_accents", result.FoldAccents); err != nil {
+		return NormalizeOptions{}, err
+	}
+	if result.Ignore, err = opts.Strings("ignore"); err != nil {
+		return NormalizeOptions{}, err
+	}
+	return result, nil
+}
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+// unicodeClasses are the character classes accepted by the ignore option.
+var unicodeClasses = map[string]func(rune) bool{
+	"whitespace":       unicode.IsSpace,
+	"punctuation":      unicode.IsPunct,
+	"symbols":          unicode.IsSymbol,
+	"marks":            unicode.IsMark,
+	"digits":           unicode.IsDigit,
+	"control":          unicode.IsControl,
+	"non_alphanumeric": func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) },
+}
+
+func unicodeClassNames() []string {
+	names := make([]string, 0, len(unicodeClasses))
+	for name := range unicodeClasses {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NormalizeUnicode case folds s, removes accents, applies the normalization
+// form and finally drops the ignored character classes, each step only if
+// requested.
+func NormalizeUnicode(s string, opts NormalizeOptions) (string, error) {
+	form, hasForm := normalizationForms[strings.ToUpper(opts.Form)]
+	if opts.Form != "" && !hasForm {
+		return "", fmt.Errorf("unsupported normalization form %q, expected one of: NFC, NFD, NFKC, NFKD", opts.Form)
+	}
+
+	ignored := make([]func(rune) bool, 0, len(opts.Ignore))
+	for _, name := range opts.Ignore {
+		class, ok := unicodeClasses[name]
+		if !ok {
+			return "", fmt.Errorf("unsupported character class %q, expected one of: %s", name, strings.Join(unicodeClassNames(), ", "))
+		}
+		ignored = append(ignored, class)
+	}
+
+	if opts.CaseFold {
+		s = cases.Fold().String(s)
+	}
+	if opts.FoldAccents {
+		s = norm.NFC.String(strings.Map(func(r rune) rune {
+			if unicode.Is(unicode.Mn, r) {
+				return -1
+			}
+			return r
+		}, norm.NFD.String(s)))
+	}
+	if hasForm {
+		s = form.String(s)
+	}
+	if len(ignored) > 0 {
+		s = strings.Map(func(r rune) rune {
+			for _, class := range ignored {
+				if class(r) {
+					return -1
+				}
+			}
+			return r
+		}, s)
+	}
+	return s, nil
+}
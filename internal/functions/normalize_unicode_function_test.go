@@ -0,0 +1,80 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestNormalizeUnicode(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    NormalizeOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "nfc composes", input: "e\u0301", opts: NormalizeOptions{Form: "NFC"}, want: "\u00e9"},
+		{name: "nfd decomposes", input: "\u00e9", opts: NormalizeOptions{Form: "NFD"}, want: "e\u0301"},
+		{name: "nfkc full-width", input: "Ｎａｍｅ", opts: NormalizeOptions{Form: "NFKC"}, want: "Name"},
+		{name: "nfkd ligature", input: "ﬁ", opts: NormalizeOptions{Form: "nfkd"}, want: "fi"},
+		{name: "no form", input: "e\u0301", want: "e\u0301"},
+		{name: "case fold sharp s", input: "Straße", opts: NormalizeOptions{CaseFold: true}, want: "strasse"},
+		{name: "case fold dotted capital i", input: "\u0130", opts: NormalizeOptions{CaseFold: true}, want: "i\u0307"},
+		{name: "case fold final sigma", input: "ΟΔΟΣ ὀδός", opts: NormalizeOptions{CaseFold: true}, want: "οδοσ ὀδόσ"},
+		{name: "fold accents", input: "Crème Brûlée", opts: NormalizeOptions{FoldAccents: true}, want: "Creme Brulee"},
+		{name: "fold accents keeps other letters", input: "Łódź", opts: NormalizeOptions{FoldAccents: true}, want: "Łodz"},
+		{name: "ignore classes", input: "a-b c!1", opts: NormalizeOptions{Ignore: []string{"punctuation", "whitespace"}}, want: "abc1"},
+		{name: "ignore marks after nfd", input: "\u00e9", opts: NormalizeOptions{Form: "NFD", Ignore: []string{"marks"}}, want: "e"},
+		{name: "unknown form", input: "x", opts: NormalizeOptions{Form: "NFZ"}, wantErr: true},
+		{name: "unknown class", input: "x", opts: NormalizeOptions{Ignore: []string{"emoji"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeUnicode(tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeUnicode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeUnicodeFunction_Run(t *testing.T) {
+	f := NewNormalizeUnicodeFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("Ｃａｆé"),
+			types.StringValue("NFKC"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"fold_accents": types.BoolType, "case_fold": types.BoolType},
+					map[string]attr.Value{"fold_accents": types.BoolValue(true), "case_fold": types.BoolValue(true)},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "cafe")
+}
@@ -0,0 +1,183 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// functionOptions holds the attributes of the optional trailing options
+// object accepted by several functions, e.g. truncate(s, 24, { unit = "runes" }).
+// Null attributes are treated as unset.
+type functionOptions map[string]attr.Value
+
+// parseOptions decodes the variadic options argument. At most one object may
+// be given, and attribute names outside allowed are rejected so that typos
+// surface as errors instead of being silently ignored.
+func parseOptions(args []types.Dynamic, allowed ...string) (functionOptions, error) {
+	opts := functionOptions{}
+	if len(args) == 0 {
+		return opts, nil
+	}
+	if len(args) > 1 {
+		return nil, fmt.Errorf("at most one options object may be given, got %d", len(args))
+	}
+	if args[0].IsNull() || args[0].IsUnderlyingValueNull() {
+		return opts, nil
+	}
+
+	var attrs map[string]attr.Value
+	switch v := args[0].UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		attrs = v.Attributes()
+	case basetypes.MapValue:
+		attrs = v.Elements()
+	default:
+		return nil, fmt.Errorf("options must be an object, got %s", v.Type(context.Background()))
+	}
+
+	for name, value := range attrs {
+		if !slices.Contains(allowed, name) {
+			sorted := slices.Clone(allowed)
+			slices.Sort(sorted)
+			return nil, fmt.Errorf("unsupported option %q, expected one of: %s", name, strings.Join(sorted, ", "))
+		}
+		if value.IsNull() {
+			continue
+		}
+		if d, ok := value.(basetypes.DynamicValue); ok {
+			value = d.UnderlyingValue()
+		}
+		opts[name] = value
+	}
+	return opts, nil
+}
+
+// String returns the named option as a string, or def when unset.
+func (o functionOptions) String(name, def string) (string, error) {
+	v, ok := o[name]
+	if !ok {
+		return def, nil
+	}
+	s, ok := v.(basetypes.StringValue)
+	if !ok {
+		return "", fmt.Errorf("option %q must be a string", name)
+	}
+	return s.ValueString(), nil
+}
+
+// Int returns the named option as a whole number, or def when unset.
+func (o functionOptions) Int(name string, def int) (int, error) {
+	v, ok := o[name]
+	if !ok {
+		return def, nil
+	}
+	switch n := v.(type) {
+	case basetypes.Int64Value:
+		return int(n.ValueInt64()), nil
+	case basetypes.NumberValue:
+		i, acc := n.ValueBigFloat().Int64()
+		if acc != big.Exact {
+			return 0, fmt.Errorf("option %q must be a whole number", name)
+		}
+		return int(i), nil
+	default:
+		return 0, fmt.Errorf("option %q must be a number", name)
+	}
+}
+
+// Float returns the named option as a number, or def when unset.
+func (o functionOptions) Float(name string, def float64) (float64, error) {
+	v, ok := o[name]
+	if !ok {
+		return def, nil
+	}
+	switch n := v.(type) {
+	case basetypes.Int64Value:
+		return float64(n.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return n.ValueFloat64(), nil
+	case basetypes.NumberValue:
+		f, _ := n.ValueBigFloat().Float64()
+		return f, nil
+	default:
+		return 0, fmt.Errorf("option %q must be a number", name)
+	}
+}
+
+// Bool returns the named option as a bool, or def when unset.
+func (o functionOptions) Bool(name string, def bool) (bool, error) {
+	v, ok := o[name]
+	if !ok {
+		return def, nil
+	}
+	b, ok := v.(basetypes.BoolValue)
+	if !ok {
+		return false, fmt.Errorf("option %q must be a bool", name)
+	}
+	return b.ValueBool(), nil
+}
+
+// Strings returns the named option as a list of strings, or nil when unset.
+func (o functionOptions) Strings(name string) ([]string, error) {
+	v, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var elems []attr.Value
+	switch l := v.(type) {
+	case basetypes.TupleValue:
+		elems = l.Elements()
+	case basetypes.ListValue:
+		elems = l.Elements()
+	case basetypes.SetValue:
+		elems = l.Elements()
+	default:
+		return nil, fmt.Errorf("option %q must be a list of strings", name)
+	}
+
+	result := make([]string, 0, len(elems))
+	for _, e := range elems {
+		s, ok := e.(basetypes.StringValue)
+		if !ok || s.IsNull() {
+			return nil, fmt.Errorf("option %q must be a list of strings", name)
+		}
+		result = append(result, s.ValueString())
+	}
+	return result, nil
+}
+
+// StringMap returns the named option as a map of strings, or nil when unset.
+func (o functionOptions) StringMap(name string) (map[string]string, error) {
+	v, ok := o[name]
+	if !ok {
+		return nil, nil
+	}
+
+	var elems map[string]attr.Value
+	switch m := v.(type) {
+	case basetypes.ObjectValue:
+		elems = m.Attributes()
+	case basetypes.MapValue:
+		elems = m.Elements()
+	default:
+		return nil, fmt.Errorf("option %q must be a map of strings", name)
+	}
+
+	result := make(map[string]string, len(elems))
+	for k, e := range elems {
+		s, ok := e.(basetypes.StringValue)
+		if !ok || s.IsNull() {
+			return nil, fmt.Errorf("option %q must be a map of strings", name)
+		}
+		result[k] = s.ValueString()
+	}
+	return result, nil
+}
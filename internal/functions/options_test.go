@@ -0,0 +1,91 @@
+package functions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseOptions(t *testing.T) {
+	obj := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{
+			"count":  types.NumberType,
+			"name":   types.StringType,
+			"flag":   types.BoolType,
+			"tags":   types.TupleType{ElemTypes: []attr.Type{types.StringType, types.StringType}},
+			"unset":  types.StringType,
+			"halves": types.NumberType,
+			"labels": types.MapType{ElemType: types.StringType},
+		},
+		map[string]attr.Value{
+			"count":  types.NumberValue(bigFloat(3)),
+			"name":   types.StringValue("x"),
+			"flag":   types.BoolValue(true),
+			"tags":   types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			"unset":  types.StringNull(),
+			"halves": types.NumberValue(bigFloat(1.5)),
+			"labels": types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prod")}),
+		},
+	))
+
+	opts, err := parseOptions([]types.Dynamic{obj}, "count", "name", "flag", "tags", "unset", "halves", "labels")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if n, err := opts.Int("count", 0); err != nil || n != 3 {
+		t.Errorf("Int(count) = %d, %v, want 3", n, err)
+	}
+	if _, err := opts.Int("halves", 0); err == nil {
+		t.Error("Int(halves) expected error for fractional number")
+	}
+	if f, err := opts.Float("halves", 0); err != nil || f != 1.5 {
+		t.Errorf("Float(halves) = %v, %v, want 1.5", f, err)
+	}
+	if f, err := opts.Float("unset", 2.5); err != nil || f != 2.5 {
+		t.Errorf("Float(unset) = %v, %v, want 2.5", f, err)
+	}
+	if _, err := opts.Float("name", 0); err == nil {
+		t.Error("Float(name) expected type error")
+	}
+	if s, err := opts.String("name", ""); err != nil || s != "x" {
+		t.Errorf("String(name) = %q, %v, want x", s, err)
+	}
+	if s, err := opts.String("unset", "default"); err != nil || s != "default" {
+		t.Errorf("String(unset) = %q, %v, want default", s, err)
+	}
+	if b, err := opts.Bool("flag", false); err != nil || !b {
+		t.Errorf("Bool(flag) = %v, %v, want true", b, err)
+	}
+	if _, err := opts.Bool("name", false); err == nil {
+		t.Error("Bool(name) expected type error")
+	}
+	if l, err := opts.Strings("tags"); err != nil || len(l) != 2 || l[1] != "b" {
+		t.Errorf("Strings(tags) = %v, %v, want [a b]", l, err)
+	}
+	if m, err := opts.StringMap("labels"); err != nil || len(m) != 1 || m["env"] != "prod" {
+		t.Errorf("StringMap(labels) = %v, %v, want map[env:prod]", m, err)
+	}
+	if m, err := opts.StringMap("unset"); err != nil || m != nil {
+		t.Errorf("StringMap(unset) = %v, %v, want nil", m, err)
+	}
+	if _, err := opts.StringMap("tags"); err == nil {
+		t.Error("StringMap(tags) expected type error")
+	}
+
+	if _, err := parseOptions([]types.Dynamic{obj}, "count"); err == nil {
+		t.Error("expected error for unsupported option")
+	}
+	if _, err := parseOptions([]types.Dynamic{obj, obj}, "count"); err == nil {
+		t.Error("expected error for more than one options object")
+	}
+	if _, err := parseOptions([]types.Dynamic{types.DynamicValue(types.StringValue("x"))}); err == nil {
+		t.Error("expected error for non-object options")
+	}
+}
+
+func bigFloat(f float64) *big.Float {
+	return big.NewFloat(f)
+}
@@ -0,0 +1,181 @@
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*palindromeAnalyzeFunction)(nil)
+
+type palindromeAnalyzeFunction struct{}
+
+func NewPalindromeAnalyzeFunction() function.Function {
+	return &palindromeAnalyzeFunction{}
+}
+
+func (f *palindromeAnalyzeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "palindrome_analyze"
+}
+
+func (f *palindromeAnalyzeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Finds the palindromes within a string",
+		Description: "The input is filtered as is_palindrome does, and accepts the same options. Returns an object with longest, the longest palindromic substring of the filtered input " +
+			"(the leftmost if there are several); palindromic_substrings, the number of positions at which a palindromic substring occurs, single characters included; " +
+			"and insertions, the fewest characters to insert to make the filtered input a palindrome.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to analyze",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with normalize, fold_accents, case_fold and ignore attributes",
+		},
+		Return: function.ObjectReturn{AttributeTypes: palindromeAnalysisAttrTypes},
+	}
+}
+
+var palindromeAnalysisAttrTypes = map[string]attr.Type{
+	"longest":                types.StringType,
+	"palindromic_substrings": types.Int64Type,
+	"insertions":             types.Int64Type,
+}
+
+func (f *palindromeAnalyzeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parsePalindromeOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := PalindromeAnalyze(input, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// PalindromeAnalysis describes the palindromes within a string.
+type PalindromeAnalysis struct {
+	// Longest is the leftmost of the longest palindromic substrings.
+	Longest string `tfsdk:"longest"`
+	// PalindromicSubstrings counts palindromic substrings by position, so
+	// "aaa" has 6: three of "a", two of "aa" and one "aaa".
+	PalindromicSubstrings int `tfsdk:"palindromic_substrings"`
+	// Insertions is the fewest characters to insert to make the string a
+	// palindrome.
+	Insertions int `tfsdk:"insertions"`
+}
+
+// PalindromeAnalyze analyzes s after the filtering described at
+// palindromeChars; the longest palindrome is therefore returned in its
+// filtered form.
+func PalindromeAnalyze(s string, opts NormalizeOptions) (PalindromeAnalysis, error) {
+	chars, err := palindromeChars(s, opts)
+	if err != nil {
+		return PalindromeAnalysis{}, err
+	}
+
+	start, length, count := manacher(chars)
+	return PalindromeAnalysis{
+		Longest:               strings.Join(chars[start:start+length], ""),
+		PalindromicSubstrings: count,
+		Insertions:            len(chars) - longestPalindromicSubsequence(chars),
+	}, nil
+}
+
+// manacher finds the leftmost longest palindromic substring of s and counts
+// the palindromic substrings in linear time. odd[i] is the number of odd
+// palindromes centred on s[i], and even[i] the number of even ones whose
+// right half starts at s[i]; each is found from the mirror image of i within
+// the rightmost palindrome seen so far, [left, right].
+func manacher(s []string) (start, length, count int) {
+	n := len(s)
+	odd := make([]int, n)
+	even := make([]int, n)
+
+	for i, left, right := 0, 0, -1; i < n; i++ {
+		k := 1
+		if i <= right {
+			k = min(odd[left+right-i], right-i+1)
+		}
+		for i-k >= 0 && i+k < n && s[i-k] == s[i+k] {
+			k++
+		}
+		odd[i] = k
+		if i+k-1 > right {
+			left, right = i-k+1, i+k-1
+		}
+	}
+
+	for i, left, right := 0, 0, -1; i < n; i++ {
+		k := 0
+		if i <= right {
+			k = min(even[left+right-i+1], right-i+1)
+		}
+		for i-k-1 >= 0 && i+k < n && s[i-k-1] == s[i+k] {
+			k++
+		}
+		even[i] = k
+		if i+k-1 > right {
+			left, right = i-k, i+k-1
+		}
+	}
+
+	better := func(from, l int) bool {
+		return l > length || (l == length && from < start)
+	}
+	for i := range s {
+		count += odd[i] + even[i]
+		if l := 2*odd[i] - 1; better(i-odd[i]+1, l) {
+			start, length = i-odd[i]+1, l
+		}
+		if l := 2 * even[i]; l > 0 && better(i-even[i], l) {
+			start, length = i-even[i], l
+		}
+	}
+	return start, length, count
+}
+
+// longestPalindromicSubsequence returns the length of the longest
+// subsequence of s that reads the same backwards, in quadratic time and
+// linear space. next holds the lengths for the substrings starting at i+1,
+// curr for those starting at i.
+func longestPalindromicSubsequence(s []string) int {
+	n := len(s)
+	if n == 0 {
+		return 0
+	}
+	next := make([]int, n)
+	curr := make([]int, n)
+	for i := n - 1; i >= 0; i-- {
+		curr[i] = 1
+		for j := i + 1; j < n; j++ {
+			switch {
+			case s[i] != s[j]:
+				curr[j] = max(next[j], curr[j-1])
+			case j == i+1:
+				curr[j] = 2
+			default:
+				curr[j] = next[j-1] + 2
+			}
+		}
+		next, curr = curr, next
+	}
+	return next[n-1]
+}
@@ -0,0 +1,156 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestPalindromeAnalyze(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    NormalizeOptions
+		want    PalindromeAnalysis
+		wantErr bool
+	}{
+		{name: "empty", input: "", want: PalindromeAnalysis{}},
+		{name: "single character", input: "x", want: PalindromeAnalysis{Longest: "x", PalindromicSubstrings: 1}},
+		{name: "odd palindrome", input: "racecar", want: PalindromeAnalysis{Longest: "racecar", PalindromicSubstrings: 10}},
+		{name: "even palindrome", input: "abba", want: PalindromeAnalysis{Longest: "abba", PalindromicSubstrings: 6}},
+		{name: "repeated character", input: "aaa", want: PalindromeAnalysis{Longest: "aaa", PalindromicSubstrings: 6}},
+		{name: "embedded", input: "forgeeksskeegfor", want: PalindromeAnalysis{Longest: "geeksskeeg", PalindromicSubstrings: 23, Insertions: 4}},
+		{name: "leftmost of equal length", input: "abacdc", want: PalindromeAnalysis{Longest: "aba", PalindromicSubstrings: 8, Insertions: 3}},
+		{name: "no repeats", input: "abcd", want: PalindromeAnalysis{Longest: "a", PalindromicSubstrings: 4, Insertions: 3}},
+		{name: "one insertion", input: "ab", want: PalindromeAnalysis{Longest: "a", PalindromicSubstrings: 2, Insertions: 1}},
+		{name: "filtered like is_palindrome", input: "Taco cat!", want: PalindromeAnalysis{Longest: "tacocat", PalindromicSubstrings: 10}},
+		{name: "graphemes", input: "éxé", opts: NormalizeOptions{Ignore: []string{}}, want: PalindromeAnalysis{Longest: "éxé", PalindromicSubstrings: 4}},
+		{name: "fold accents", input: "Ésé", opts: NormalizeOptions{FoldAccents: true}, want: PalindromeAnalysis{Longest: "ese", PalindromicSubstrings: 4}},
+		{name: "unknown class", input: "x", opts: NormalizeOptions{Ignore: []string{"vowels"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PalindromeAnalyze(tt.input, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PalindromeAnalyze() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PalindromeAnalyze(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPalindromeAnalyze_BruteForce checks every string of up to 7 characters
+// over a three-letter alphabet against a direct search.
+func TestPalindromeAnalyze_BruteForce(t *testing.T) {
+	isPalindrome := func(s string) bool {
+		for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+			if s[i] != s[j] {
+				return false
+			}
+		}
+		return true
+	}
+	// insertions counts the insertions needed by trying both ends.
+	var insertions func(s string) int
+	insertions = func(s string) int {
+		if len(s) < 2 {
+			return 0
+		}
+		if s[0] == s[len(s)-1] {
+			return insertions(s[1 : len(s)-1])
+		}
+		return 1 + min(insertions(s[1:]), insertions(s[:len(s)-1]))
+	}
+
+	inputs := []string{""}
+	for length := 1; length <= 7; length++ {
+		var next []string
+		for _, s := range inputs {
+			if len(s) == length-1 {
+				next = append(next, s+"a", s+"b", s+"c")
+			}
+		}
+		inputs = append(inputs, next...)
+	}
+
+	for _, s := range inputs {
+		var want PalindromeAnalysis
+		for l := len(s); l > 0 && want.Longest == ""; l-- {
+			for i := 0; i+l <= len(s); i++ {
+				if isPalindrome(s[i : i+l]) {
+					want.Longest = s[i : i+l]
+					break
+				}
+			}
+		}
+		for i := range s {
+			for j := i + 1; j <= len(s); j++ {
+				if isPalindrome(s[i:j]) {
+					want.PalindromicSubstrings++
+				}
+			}
+		}
+		want.Insertions = insertions(s)
+
+		got, err := PalindromeAnalyze(s, NormalizeOptions{})
+		if err != nil {
+			t.Fatalf("PalindromeAnalyze(%q) unexpected error: %s", s, err)
+		}
+		if got != want {
+			t.Fatalf("PalindromeAnalyze(%q) = %+v, want %+v", s, got, want)
+		}
+	}
+}
+
+func TestPalindromeAnalyzeFunction_Run(t *testing.T) {
+	f := NewPalindromeAnalyzeFunction()
+
+	result := function.NewResultData(basetypes.NewObjectNull(palindromeAnalysisAttrTypes))
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("Was it a car or a cat I saw?"),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	got, ok := resp.Result.Value().(basetypes.ObjectValue)
+	if !ok {
+		t.Fatalf("result is not an ObjectValue, got %T", resp.Result.Value())
+	}
+	want := types.ObjectValueMust(palindromeAnalysisAttrTypes, map[string]attr.Value{
+		"longest":                types.StringValue("wasitacaroracatisaw"),
+		"palindromic_substrings": types.Int64Value(30),
+		"insertions":             types.Int64Value(0),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() result = %v, want %v", got, want)
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("x"),
+		types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+			types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"normalize": types.StringType},
+				map[string]attr.Value{"normalize": types.StringValue("NFX")},
+			)),
+		}),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewObjectNull(palindromeAnalysisAttrTypes))}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Error("expected error for unknown normalization form")
+	}
+}
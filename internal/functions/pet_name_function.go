@@ -0,0 +1,196 @@
+package functions
+
+import (
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*petNameFunction)(nil)
+
+type petNameFunction struct{}
+
+func NewPetNameFunction() function.Function {
+	return &petNameFunction{}
+}
+
+func (f *petNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pet_name"
+}
+
+func (f *petNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generates a readable, deterministic name such as brave-otter-42 from a seed",
+		Description: "The same seed always yields the same name. Options: words (1 to 4, default 2) is the number of words, ending with an animal preceded by an adjective and adverbs; " +
+			"digits (0 to 9, default 2) appends a zero-padded number; separator (default \"-\") joins the parts; max_word_length limits the words that may be chosen; " +
+			"max_length shortens the result as truncate does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "seed",
+				Description: "The value the name is derived from, e.g. a workspace name",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with words, digits, separator, max_word_length and max_length attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *petNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var seed string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &seed, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parsePetNameOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := PetName(seed, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+var (
+	//go:embed wordlists/adverbs.txt
+	adverbsList string
+	//go:embed wordlists/adjectives.txt
+	adjectivesList string
+	//go:embed wordlists/animals.txt
+	animalsList string
+
+	petNameAdverbs    = strings.Fields(adverbsList)
+	petNameAdjectives = strings.Fields(adjectivesList)
+	petNameAnimals    = strings.Fields(animalsList)
+)
+
+// PetNameOptions controls the shape of names generated by PetName.
+type PetNameOptions struct {
+	// Words is the number of words, from 1 to 4. The last word is an animal,
+	// the one before it an adjective, and any others are adverbs.
+	Words int
+	// Digits is the number of digits appended, from 0 to 9.
+	Digits int
+	// Separator joins words and the number.
+	Separator string
+	// MaxWordLength excludes longer words from selection. Zero means no limit.
+	MaxWordLength int
+	// MaxLength shortens the result with TruncateWithOptions, using Separator
+	// before the hash. Zero means no limit.
+	MaxLength int
+}
+
+// DefaultPetNameOptions returns the options for names like "brave-otter-42".
+func DefaultPetNameOptions() PetNameOptions {
+	return PetNameOptions{Words: 2, Digits: 2, Separator: "-"}
+}
+
+func parsePetNameOptions(args []types.Dynamic) (PetNameOptions, error) {
+	opts, err := parseOptions(args, "words", "digits", "separator", "max_word_length", "max_length")
+	if err != nil {
+		return PetNameOptions{}, err
+	}
+
+	result := DefaultPetNameOptions()
+	if result.Words, err = opts.Int("words", result.Words); err != nil {
+		return PetNameOptions{}, err
+	}
+	if result.Digits, err = opts.Int("digits", result.Digits); err != nil {
+		return PetNameOptions{}, err
+	}
+	if result.Separator, err = opts.String("separator", result.Separator); err != nil {
+		return PetNameOptions{}, err
+	}
+	if result.MaxWordLength, err = opts.Int("max_word_length", result.MaxWordLength); err != nil {
+		return PetNameOptions{}, err
+	}
+	if result.MaxLength, err = opts.Int("max_length", result.MaxLength); err != nil {
+		return PetNameOptions{}, err
+	}
+	return result, nil
+}
+
+// PetName derives a readable name from seed. Each word and the number are
+// picked with a separate slice of the seed's SHA-256 digest, so the result
+// is stable for a given seed and options.
+func PetName(seed string, opts PetNameOptions) (string, error) {
+	if opts.Words < 1 || opts.Words > 4 {
+		return "", fmt.Errorf("words must be between 1 and 4, got %d", opts.Words)
+	}
+	if opts.Digits < 0 || opts.Digits > 9 {
+		return "", fmt.Errorf("digits must be between 0 and 9, got %d", opts.Digits)
+	}
+	if opts.MaxWordLength < 0 {
+		return "", fmt.Errorf("max_word_length must not be negative, got %d", opts.MaxWordLength)
+	}
+	if opts.MaxLength < 0 {
+		return "", fmt.Errorf("max_length must not be negative, got %d", opts.MaxLength)
+	}
+
+	lists := make([][]string, 0, opts.Words)
+	for i := 0; i < opts.Words-2; i++ {
+		lists = append(lists, petNameAdverbs)
+	}
+	if opts.Words >= 2 {
+		lists = append(lists, petNameAdjectives)
+	}
+	lists = append(lists, petNameAnimals)
+
+	digest := sha256.Sum256([]byte(seed))
+	parts := make([]string, 0, len(lists)+1)
+	for i, list := range lists {
+		words := filterWordLength(list, opts.MaxWordLength)
+		if len(words) == 0 {
+			return "", fmt.Errorf("no words are at most %d characters long", opts.MaxWordLength)
+		}
+		n := binary.BigEndian.Uint32(digest[i*4:])
+		parts = append(parts, words[n%uint32(len(words))])
+	}
+
+	if opts.Digits > 0 {
+		limit := uint32(1)
+		for i := 0; i < opts.Digits; i++ {
+			limit *= 10
+		}
+		n := binary.BigEndian.Uint32(digest[28:])
+		parts = append(parts, fmt.Sprintf("%0*d", opts.Digits, n%limit))
+	}
+
+	name := strings.Join(parts, opts.Separator)
+	if opts.MaxLength == 0 {
+		return name, nil
+	}
+
+	truncateOpts := DefaultTruncateOptions()
+	truncateOpts.Separator = opts.Separator
+	return TruncateWithOptions(name, opts.MaxLength, truncateOpts)
+}
+
+func filterWordLength(words []string, maxLength int) []string {
+	if maxLength == 0 {
+		return words
+	}
+	result := make([]string, 0, len(words))
+	for _, w := range words {
+		if len(w) <= maxLength {
+			result = append(result, w)
+		}
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package functions
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestPetName(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    string
+		modify  func(o *PetNameOptions)
+		pattern string
+		wantErr bool
+	}{
+		{name: "default shape", seed: "prod-eu", modify: func(o *PetNameOptions) {}, pattern: `^[a-z]+-[a-z]+-[0-9]{2}$`},
+		{name: "single word", seed: "prod-eu", modify: func(o *PetNameOptions) { o.Words = 1; o.Digits = 0 }, pattern: `^[a-z]+$`},
+		{name: "four words", seed: "prod-eu", modify: func(o *PetNameOptions) { o.Words = 4 }, pattern: `^([a-z]+-){4}[0-9]{2}$`},
+		{name: "custom separator", seed: "prod-eu", modify: func(o *PetNameOptions) { o.Separator = "_"; o.Digits = 4 }, pattern: `^[a-z]+_[a-z]+_[0-9]{4}$`},
+		{name: "empty separator", seed: "prod-eu", modify: func(o *PetNameOptions) { o.Separator = ""; o.Digits = 0 }, pattern: `^[a-z]+$`},
+		{name: "empty seed", seed: "", modify: func(o *PetNameOptions) {}, pattern: `^[a-z]+-[a-z]+-[0-9]{2}$`},
+		{name: "too many words", seed: "x", modify: func(o *PetNameOptions) { o.Words = 5 }, wantErr: true},
+		{name: "zero words", seed: "x", modify: func(o *PetNameOptions) { o.Words = 0 }, wantErr: true},
+		{name: "too many digits", seed: "x", modify: func(o *PetNameOptions) { o.Digits = 10 }, wantErr: true},
+		{name: "word length too small", seed: "x", modify: func(o *PetNameOptions) { o.MaxWordLength = 1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultPetNameOptions()
+			tt.modify(&opts)
+			got, err := PetName(tt.seed, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PetName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !regexp.MustCompile(tt.pattern).MatchString(got) {
+				t.Errorf("PetName() = %q, does not match %s", got, tt.pattern)
+			}
+		})
+	}
+}
+
+func TestPetName_Deterministic(t *testing.T) {
+	opts := DefaultPetNameOptions()
+	a, _ := PetName("payments-prod", opts)
+	b, _ := PetName("payments-prod", opts)
+	c, _ := PetName("payments-staging", opts)
+	assertEqual(t, a, b)
+	if a == c {
+		t.Errorf("different seeds produced the same name %q", a)
+	}
+}
+
+func TestPetName_Limits(t *testing.T) {
+	opts := DefaultPetNameOptions()
+	opts.Words = 4
+	opts.MaxWordLength = 4
+	got, err := PetName("limits", opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range strings.Split(got, "-") {
+		if len(w) > 4 {
+			t.Errorf("word %q in %q exceeds max_word_length", w, got)
+		}
+	}
+
+	opts = DefaultPetNameOptions()
+	opts.Words = 4
+	for maxLength := 1; maxLength <= 40; maxLength++ {
+		opts.MaxLength = maxLength
+		got, err := PetName("limits", opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) > maxLength {
+			t.Errorf("PetName() = %q, exceeds max_length %d", got, maxLength)
+		}
+	}
+}
+
+func TestPetNameWordLists(t *testing.T) {
+	for name, list := range map[string][]string{
+		"adverbs":    petNameAdverbs,
+		"adjectives": petNameAdjectives,
+		"animals":    petNameAnimals,
+	} {
+		if len(list) < 50 {
+			t.Errorf("%s list has only %d words", name, len(list))
+		}
+		seen := make(map[string]bool, len(list))
+		for _, w := range list {
+			if !regexp.MustCompile(`^[a-z]+$`).MatchString(w) {
+				t.Errorf("%s list contains invalid word %q", name, w)
+			}
+			if seen[w] {
+				t.Errorf("%s list contains duplicate %q", name, w)
+			}
+			seen[w] = true
+		}
+	}
+}
+
+func TestPetNameFunction_Run(t *testing.T) {
+	f := NewPetNameFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("payments-prod"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"words": types.NumberType, "digits": types.NumberType},
+					map[string]attr.Value{"words": types.NumberValue(bigFloat(3)), "digits": types.NumberValue(bigFloat(0))},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	if !regexp.MustCompile(`^[a-z]+-[a-z]+-[a-z]+$`).MatchString(got.ValueString()) {
+		t.Errorf("pet_name() = %q, want three words", got.ValueString())
+	}
+}
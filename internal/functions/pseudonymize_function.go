@@ -0,0 +1,210 @@
+package functions
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*pseudonymizeFunction)(nil)
+
+type pseudonymizeFunction struct{}
+
+func NewPseudonymizeFunction() function.Function {
+	return &pseudonymizeFunction{}
+}
+
+func (f *pseudonymizeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "pseudonymize"
+}
+
+func (f *pseudonymizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Replaces an identifier with a stable token derived from it with HMAC-SHA256 and a secret key",
+		Description: "The same input and key always yield the same token, and the input cannot be recovered without the key. " +
+			"Options: alphabet (default 0-9a-z) lists the characters tokens are made of; length (default 16) is the number of token characters; " +
+			"preserve_format replaces each digit with a digit and each letter with a letter of the same case, keeping other characters and the input's length, and ignores alphabet and length; " +
+			"prefix (default none) is prepended to the token.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The identifier to pseudonymize",
+			},
+			function.StringParameter{
+				Name:        "key",
+				Description: "The secret HMAC key",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with alphabet, length, preserve_format and prefix attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *pseudonymizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input, key string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &key, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	if key == "" {
+		resp.Error = function.NewArgumentFuncError(1, "key must not be empty")
+		return
+	}
+
+	opts, err := parsePseudonymizeOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := Pseudonymize(input, key, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+const (
+	pseudonymAlphabet  = "0123456789abcdefghijklmnopqrstuvwxyz"
+	pseudonymMaxLength = 256
+)
+
+// PseudonymizeOptions controls the tokens produced by Pseudonymize.
+type PseudonymizeOptions struct {
+	// Alphabet lists the distinct characters tokens are made of, at most 256.
+	Alphabet string
+	// Length is the number of token characters, from 1 to 256.
+	Length int
+	// PreserveFormat maps digits to ASCII digits, upper-case letters to
+	// A-Z and other letters to a-z, leaving other characters alone. Alphabet
+	// and Length are ignored.
+	PreserveFormat bool
+	// Prefix is prepended to the token.
+	Prefix string
+}
+
+// DefaultPseudonymizeOptions returns options for 16-character lowercase
+// alphanumeric tokens.
+func DefaultPseudonymizeOptions() PseudonymizeOptions {
+	return PseudonymizeOptions{Alphabet: pseudonymAlphabet, Length: 16}
+}
+
+func parsePseudonymizeOptions(args []types.Dynamic) (PseudonymizeOptions, error) {
+	opts, err := parseOptions(args, "alphabet", "length", "preserve_format", "prefix")
+	if err != nil {
+		return PseudonymizeOptions{}, err
+	}
+
+	result := DefaultPseudonymizeOptions()
+	if result.Alphabet, err = opts.String("alphabet", result.Alphabet); err != nil {
+		return PseudonymizeOptions{}, err
+	}
+	if result.Length, err = opts.Int("length", result.Length); err != nil {
+		return PseudonymizeOptions{}, err
+	}
+	if result.PreserveFormat, err = opts.Bool("preserve_format", result.PreserveFormat); err != nil {
+		return PseudonymizeOptions{}, err
+	}
+	if result.Prefix, err = opts.String("prefix", result.Prefix); err != nil {
+		return PseudonymizeOptions{}, err
+	}
+	return result, nil
+}
+
+// Pseudonymize derives a token from s with HMAC-SHA256 keyed by key. Token
+// characters are drawn from an HMAC output stream by rejection sampling, so
+// every character of the alphabet is equally likely.
+func Pseudonymize(s, key string, opts PseudonymizeOptions) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("key must not be empty")
+	}
+
+	stream := newHMACStream([]byte(key), []byte(s))
+	if opts.PreserveFormat {
+		var b strings.Builder
+		b.WriteString(opts.Prefix)
+		for _, r := range s {
+			switch {
+			case unicode.IsDigit(r):
+				r = '0' + rune(stream.intn(10))
+			case unicode.IsUpper(r):
+				r = 'A' + rune(stream.intn(26))
+			case unicode.IsLetter(r):
+				r = 'a' + rune(stream.intn(26))
+			}
+			b.WriteRune(r)
+		}
+		return b.String(), nil
+	}
+
+	alphabet := []rune(opts.Alphabet)
+	if len(alphabet) < 2 || len(alphabet) > 256 {
+		return "", fmt.Errorf("alphabet must have between 2 and 256 characters, got %d", len(alphabet))
+	}
+	seen := make(map[rune]bool, len(alphabet))
+	for _, r := range alphabet {
+		if seen[r] {
+			return "", fmt.Errorf("alphabet contains %q more than once", r)
+		}
+		seen[r] = true
+	}
+	if opts.Length < 1 || opts.Length > pseudonymMaxLength {
+		return "", fmt.Errorf("length must be between 1 and %d, got %d", pseudonymMaxLength, opts.Length)
+	}
+
+	token := make([]rune, opts.Length)
+	for i := range token {
+		token[i] = alphabet[stream.intn(len(alphabet))]
+	}
+	return opts.Prefix + string(token), nil
+}
+
+// hmacStream yields the bytes of HMAC(key, counter || message) for
+// counter = 0, 1, 2, ... as one continuous stream.
+type hmacStream struct {
+	key, message []byte
+	counter      uint32
+	buf          []byte
+}
+
+func newHMACStream(key, message []byte) *hmacStream {
+	return &hmacStream{key: key, message: message}
+}
+
+func (s *hmacStream) next() byte {
+	if len(s.buf) == 0 {
+		mac := hmac.New(sha256.New, s.key)
+		_ = binary.Write(mac, binary.BigEndian, s.counter)
+		mac.Write(s.message)
+		s.buf = mac.Sum(nil)
+		s.counter++
+	}
+	b := s.buf[0]
+	s.buf = s.buf[1:]
+	return b
+}
+
+// intn returns a uniformly distributed number in [0, n) for n <= 256,
+// discarding bytes that would bias the result.
+func (s *hmacStream) intn(n int) int {
+	limit := 256 - 256%n
+	for {
+		if b := int(s.next()); b < limit {
+			return b % n
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestPseudonymize(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		key     string
+		modify  func(o *PseudonymizeOptions)
+		want    string
+		wantErr bool
+	}{
+		{name: "defaults", input: "customer-42", key: "k", want: "pbaahmaef6d1piwp"},
+		{name: "preserve format", input: "4111-1111 Ab", key: "k", modify: func(o *PseudonymizeOptions) { o.PreserveFormat = true }, want: "3564-4884 Kc"},
+		{
+			name:   "alphabet, length and prefix",
+			input:  "customer-42",
+			key:    "k",
+			modify: func(o *PseudonymizeOptions) { o.Alphabet = "01"; o.Length = 8; o.Prefix = "u_" },
+			want:   "u_11001000",
+		},
+		{name: "empty key", input: "x", key: "", wantErr: true},
+		{name: "alphabet too small", input: "x", key: "k", modify: func(o *PseudonymizeOptions) { o.Alphabet = "a" }, wantErr: true},
+		{name: "duplicate alphabet", input: "x", key: "k", modify: func(o *PseudonymizeOptions) { o.Alphabet = "abca" }, wantErr: true},
+		{name: "zero length", input: "x", key: "k", modify: func(o *PseudonymizeOptions) { o.Length = 0 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultPseudonymizeOptions()
+			if tt.modify != nil {
+				tt.modify(&opts)
+			}
+			got, err := Pseudonymize(tt.input, tt.key, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Pseudonymize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPseudonymize_Properties(t *testing.T) {
+	opts := DefaultPseudonymizeOptions()
+	a1, _ := Pseudonymize("alice@example.com", "key-1", opts)
+	a2, _ := Pseudonymize("alice@example.com", "key-1", opts)
+	b, _ := Pseudonymize("bob@example.com", "key-1", opts)
+	c, _ := Pseudonymize("alice@example.com", "key-2", opts)
+
+	if a1 != a2 {
+		t.Errorf("same input and key gave %q and %q", a1, a2)
+	}
+	if a1 == b {
+		t.Errorf("different inputs both gave %q", a1)
+	}
+	if a1 == c {
+		t.Errorf("different keys both gave %q", a1)
+	}
+
+	opts.Length = pseudonymMaxLength
+	long, _ := Pseudonymize("alice@example.com", "key-1", opts)
+	if len(long) != pseudonymMaxLength || !strings.HasPrefix(long, a1) {
+		t.Errorf("long token %q should extend the default token %q", long, a1)
+	}
+
+	opts.PreserveFormat = true
+	shaped, _ := Pseudonymize("Ünïcødé 12", "key-1", opts)
+	if len([]rune(shaped)) != len([]rune("Ünïcødé 12")) || shaped[0] < 'A' || shaped[0] > 'Z' {
+		t.Errorf("format-preserving token %q does not match the input's shape", shaped)
+	}
+}
+
+func TestPseudonymizeFunction_Run(t *testing.T) {
+	f := NewPseudonymizeFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("customer-42"),
+			types.StringValue("k"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"prefix": types.StringType},
+					map[string]attr.Value{"prefix": types.StringValue("cust_")},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "cust_pbaahmaef6d1piwp")
+}
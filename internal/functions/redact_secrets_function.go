@@ -0,0 +1,84 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*redactSecretsFunction)(nil)
+
+type redactSecretsFunction struct{}
+
+func NewRedactSecretsFunction() function.Function {
+	return &redactSecretsFunction{}
+}
+
+func (f *redactSecretsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "redact_secrets"
+}
+
+func (f *redactSecretsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Masks credentials found in free-form text",
+		Description: "Secrets are found as detect_secrets does and replaced as mask does; the rest of the text is unchanged. " +
+			"Options: types limits redaction to the listed secret types; entropy_threshold (default 4) is the minimum entropy of high_entropy findings; " +
+			"show_first, show_last, mask_char, fixed_length, min_masked and unit control the mask as for mask (default: every character is replaced with \"*\").",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "The text to redact",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with types, entropy_threshold, show_first, show_last, mask_char, fixed_length, min_masked and unit attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *redactSecretsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	scan, mask, err := parseRedactSecretsOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := RedactSecrets(text, scan, mask)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+func parseRedactSecretsOptions(args []types.Dynamic) (SecretScanOptions, MaskOptions, error) {
+	opts, err := parseOptions(args, "types", "entropy_threshold", "show_first", "show_last", "mask_char", "fixed_length", "min_masked", "unit")
+	if err != nil {
+		return SecretScanOptions{}, MaskOptions{}, err
+	}
+
+	scan := DefaultSecretScanOptions()
+	if scan.Types, err = opts.Strings("types"); err != nil {
+		return SecretScanOptions{}, MaskOptions{}, err
+	}
+	if scan.EntropyThreshold, err = opts.Float("entropy_threshold", scan.EntropyThreshold); err != nil {
+		return SecretScanOptions{}, MaskOptions{}, err
+	}
+
+	mask, err := maskOptionsFrom(opts)
+	if err != nil {
+		return SecretScanOptions{}, MaskOptions{}, err
+	}
+	return scan, mask, nil
+}
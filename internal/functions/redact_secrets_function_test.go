@@ -0,0 +1,85 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestRedactSecretsFunction_Run(t *testing.T) {
+	text := "AWS_ACCESS_KEY_ID=" + testAWSKey + "\nGITHUB_TOKEN=" + testGitHubToken
+
+	tests := []struct {
+		name    string
+		options []attr.Value
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			want: "AWS_ACCESS_KEY_ID=********************\nGITHUB_TOKEN=****************************************",
+		},
+		{
+			name: "types and fixed length",
+			options: []attr.Value{types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{
+					"types":        types.TupleType{ElemTypes: []attr.Type{types.StringType}},
+					"fixed_length": types.NumberType,
+				},
+				map[string]attr.Value{
+					"types":        types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("github_token")}),
+					"fixed_length": types.NumberValue(bigFloat(8)),
+				},
+			))},
+			want: "AWS_ACCESS_KEY_ID=" + testAWSKey + "\nGITHUB_TOKEN=********",
+		},
+		{
+			name: "unsupported type",
+			options: []attr.Value{types.DynamicValue(types.ObjectValueMust(
+				map[string]attr.Type{"types": types.TupleType{ElemTypes: []attr.Type{types.StringType}}},
+				map[string]attr.Value{"types": types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("ssn")})},
+			))},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewRedactSecretsFunction()
+			ctx := context.Background()
+
+			elemTypes := make([]attr.Type, len(tt.options))
+			for i := range elemTypes {
+				elemTypes[i] = types.DynamicType
+			}
+
+			result := function.NewResultData(basetypes.NewStringNull())
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(text),
+					types.TupleValueMust(elemTypes, tt.options),
+				}),
+			}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(ctx, req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("unexpected error state: %v", resp.Error)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+			}
+			assertEqual(t, got.ValueString(), tt.want)
+		})
+	}
+}
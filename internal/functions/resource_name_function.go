@@ -0,0 +1,227 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*resourceNameFunction)(nil)
+
+type resourceNameFunction struct{}
+
+func NewResourceNameFunction() function.Function {
+	return &resourceNameFunction{}
+}
+
+func (f *resourceNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resource_name"
+}
+
+func (f *resourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a resource name from components that satisfies a cloud provider's naming rules",
+		Description: "Components are joined with the target's separator, invalid characters are replaced, case rules are applied, and names that are too long are shortened with a hash suffix as truncate does. " +
+			"Supported targets: " + strings.Join(resourceNameTargets(), ", ") + ".",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "components",
+				Description: "The name components, joined in order",
+				ElementType: types.StringType,
+			},
+			function.StringParameter{
+				Name:        "target",
+				Description: "The kind of resource the name is for, e.g. s3_bucket",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *resourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var components []string
+	var target string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &components, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	rule, ok := resourceNameRules[target]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("unsupported target %q, expected one of: %s", target, strings.Join(resourceNameTargets(), ", ")))
+		return
+	}
+
+	result, err := rule.build(components)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("cannot build %s name: %s", target, err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// nameRule describes the naming constraints of one kind of resource.
+// Letters and digits are always allowed; Punctuation lists the other
+// characters that may appear.
+type nameRule struct {
+	MinLength   int
+	MaxLength   int
+	Lowercase   bool
+	Punctuation string
+	// Separator joins components and replaces invalid characters. It must be
+	// empty or one of Punctuation.
+	Separator string
+	// StartLetter requires the first character to be a letter.
+	StartLetter bool
+	// EdgeAlphanumeric requires the first and last characters to be a letter
+	// or digit; other characters at the edges are trimmed.
+	EdgeAlphanumeric bool
+	// SinglePunctuation forbids adjacent punctuation such as ".." or "--";
+	// runs are collapsed to their first character.
+	SinglePunctuation bool
+	// Check enforces any remaining target-specific rule on the final name.
+	Check func(name string) error
+}
+
+var resourceNameRules = map[string]nameRule{
+	"s3_bucket": {
+		MinLength: 3, MaxLength: 63, Lowercase: true, Punctuation: ".-", Separator: "-",
+		EdgeAlphanumeric: true, SinglePunctuation: true,
+		Check: checkS3BucketName,
+	},
+	"azure_storage_account": {
+		MinLength: 3, MaxLength: 24, Lowercase: true,
+	},
+	"azure_key_vault": {
+		MinLength: 3, MaxLength: 24, Punctuation: "-", Separator: "-",
+		StartLetter: true, EdgeAlphanumeric: true, SinglePunctuation: true,
+	},
+	"gcp_project_id": {
+		MinLength: 6, MaxLength: 30, Lowercase: true, Punctuation: "-", Separator: "-",
+		StartLetter: true, EdgeAlphanumeric: true,
+	},
+	"aws_iam_role": {
+		MinLength: 1, MaxLength: 64, Punctuation: "+=,.@_-", Separator: "-",
+	},
+	"k8s_dns_label": {
+		MinLength: 1, MaxLength: 63, Lowercase: true, Punctuation: "-", Separator: "-",
+		EdgeAlphanumeric: true,
+	},
+	"k8s_dns_subdomain": {
+		MinLength: 1, MaxLength: 253, Lowercase: true, Punctuation: ".-", Separator: "-",
+		EdgeAlphanumeric: true,
+	},
+}
+
+func resourceNameTargets() []string {
+	targets := make([]string, 0, len(resourceNameRules))
+	for t := range resourceNameRules {
+		targets = append(targets, t)
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+// ResourceName joins components into a name that satisfies the rules of the
+// given target, shortening it with a hash suffix when it is too long.
+func ResourceName(components []string, target string) (string, error) {
+	rule, ok := resourceNameRules[target]
+	if !ok {
+		return "", fmt.Errorf("unsupported target %q, expected one of: %s", target, strings.Join(resourceNameTargets(), ", "))
+	}
+	return rule.build(components)
+}
+
+func (r nameRule) build(components []string) (string, error) {
+	parts := make([]string, 0, len(components))
+	for _, c := range components {
+		if c != "" {
+			parts = append(parts, c)
+		}
+	}
+
+	name := r.clean(strings.Join(parts, r.Separator))
+	if len(name) > r.MaxLength {
+		opts := DefaultTruncateOptions()
+		opts.Separator = r.Separator
+		truncated, err := TruncateWithOptions(name, r.MaxLength, opts)
+		if err != nil {
+			return "", err
+		}
+		name = r.clean(truncated)
+	}
+
+	if len(name) < r.MinLength {
+		return "", fmt.Errorf("name %q is shorter than the minimum length of %d", name, r.MinLength)
+	}
+	if r.StartLetter && !isASCIILetter(name[0]) {
+		return "", fmt.Errorf("name %q must start with a letter", name)
+	}
+	if r.Check != nil {
+		if err := r.Check(name); err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
+
+// clean applies the case and character rules to s.
+func (r nameRule) clean(s string) string {
+	if r.Lowercase {
+		s = strings.ToLower(s)
+	}
+
+	var b strings.Builder
+	var prev rune
+	for _, c := range s {
+		switch {
+		case c < 0x80 && (isASCIILetter(byte(c)) || isASCIIDigit(byte(c))):
+		case strings.ContainsRune(r.Punctuation, c):
+			if r.SinglePunctuation && prev != 0 && strings.ContainsRune(r.Punctuation, prev) {
+				continue
+			}
+		case r.Separator != "":
+			c = rune(r.Separator[0])
+			if prev == c || (r.SinglePunctuation && prev != 0 && strings.ContainsRune(r.Punctuation, prev)) {
+				continue
+			}
+		default:
+			continue
+		}
+		b.WriteRune(c)
+		prev = c
+	}
+
+	name := b.String()
+	if r.EdgeAlphanumeric {
+		name = strings.TrimFunc(name, func(c rune) bool {
+			return c >= 0x80 || !(isASCIILetter(byte(c)) || isASCIIDigit(byte(c)))
+		})
+	}
+	return name
+}
+
+func checkS3BucketName(name string) error {
+	if _, err := netip.ParseAddr(name); err == nil {
+		return fmt.Errorf("name %q must not be formatted as an IP address", name)
+	}
+	// The reserved "xn--" prefix and "--ol-s3" suffix cannot occur because
+	// adjacent punctuation is collapsed.
+	if strings.HasPrefix(name, "sthree-") {
+		return fmt.Errorf("name %q must not start with the reserved prefix sthree-", name)
+	}
+	if strings.HasSuffix(name, "-s3alias") {
+		return fmt.Errorf("name %q must not end with the reserved suffix -s3alias", name)
+	}
+	return nil
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
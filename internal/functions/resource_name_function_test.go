@@ -0,0 +1,131 @@
+package functions
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestResourceName(t *testing.T) {
+	tests := []struct {
+		name       string
+		components []string
+		target     string
+		want       string
+		wantLen    int
+		wantErr    string
+	}{
+		{name: "s3 lowercased", components: []string{"Acme", "Prod", "Logs"}, target: "s3_bucket", want: "acme-prod-logs"},
+		{name: "s3 invalid characters", components: []string{"acme_corp", "logs!"}, target: "s3_bucket", want: "acme-corp-logs"},
+		{name: "s3 no adjacent punctuation", components: []string{"acme..corp", ".logs"}, target: "s3_bucket", want: "acme.corp-logs"},
+		{name: "s3 edges trimmed", components: []string{"-acme", "logs."}, target: "s3_bucket", want: "acme-logs"},
+		{name: "s3 too long", components: []string{strings.Repeat("a", 40), strings.Repeat("b", 40)}, target: "s3_bucket", wantLen: 63},
+		{name: "s3 ip address", components: []string{"10.0.0.1"}, target: "s3_bucket", wantErr: "IP address"},
+		{name: "s3 reserved prefix", components: []string{"sthree", "bucket"}, target: "s3_bucket", wantErr: "reserved prefix"},
+		{name: "s3 reserved suffix", components: []string{"bucket", "s3alias"}, target: "s3_bucket", wantErr: "reserved suffix"},
+		{name: "s3 punycode prefix collapsed", components: []string{"xn--bucket"}, target: "s3_bucket", want: "xn-bucket"},
+		{name: "s3 too short", components: []string{"a", "!"}, target: "s3_bucket", wantErr: "minimum length of 3"},
+		{name: "storage account strips punctuation", components: []string{"acme", "prod", "diag"}, target: "azure_storage_account", want: "acmeproddiag"},
+		{name: "storage account too long", components: []string{"acme", "production", "westeurope", "diagnostics"}, target: "azure_storage_account", wantLen: 24},
+		{name: "key vault keeps case", components: []string{"Acme", "KV"}, target: "azure_key_vault", want: "Acme-KV"},
+		{name: "key vault no double hyphen", components: []string{"acme-", "-kv"}, target: "azure_key_vault", want: "acme-kv"},
+		{name: "key vault must start with letter", components: []string{"1acme", "kv"}, target: "azure_key_vault", wantErr: "must start with a letter"},
+		{name: "key vault too long", components: []string{"acme", "production", "westeurope", "kv"}, target: "azure_key_vault", wantLen: 24},
+		{name: "gcp project id", components: []string{"Acme", "Data Platform"}, target: "gcp_project_id", want: "acme-data-platform"},
+		{name: "gcp project too short", components: []string{"ab"}, target: "gcp_project_id", wantErr: "minimum length of 6"},
+		{name: "iam role keeps punctuation", components: []string{"Acme", "Deploy@CI"}, target: "aws_iam_role", want: "Acme-Deploy@CI"},
+		{name: "k8s label", components: []string{"My_App", "v1.2"}, target: "k8s_dns_label", want: "my-app-v1-2"},
+		{name: "k8s subdomain keeps dots", components: []string{"api", "example.com"}, target: "k8s_dns_subdomain", want: "api-example.com"},
+		{name: "empty components skipped", components: []string{"acme", "", "logs"}, target: "s3_bucket", want: "acme-logs"},
+		{name: "unknown target", components: []string{"acme"}, target: "dynamodb_table", wantErr: "unsupported target"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResourceName(tt.components, tt.target)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResourceName() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResourceName() unexpected error: %s", err)
+			}
+			if tt.want != "" {
+				assertEqual(t, got, tt.want)
+			}
+			if tt.wantLen != 0 && len(got) != tt.wantLen {
+				t.Errorf("len(%q) = %d, want %d", got, len(got), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestResourceName_Distinct(t *testing.T) {
+	a, err := ResourceName([]string{"acme", "production", "westeurope", "diagnostics", "a"}, "azure_storage_account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ResourceName([]string{"acme", "production", "westeurope", "diagnostics", "b"}, "azure_storage_account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b {
+		t.Errorf("expected distinct names, both are %q", a)
+	}
+}
+
+func TestResourceNameFunction_Run(t *testing.T) {
+	f := NewResourceNameFunction()
+
+	tests := []struct {
+		name       string
+		components []string
+		target     string
+		want       string
+		wantErr    bool
+	}{
+		{"valid", []string{"acme", "logs"}, "s3_bucket", "acme-logs", false},
+		{"rule not satisfied", []string{"1acme"}, "azure_key_vault", "", true},
+		{"unknown target", []string{"acme"}, "nope", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elems := make([]attr.Value, len(tt.components))
+			for i, c := range tt.components {
+				elems[i] = types.StringValue(c)
+			}
+
+			result := function.NewResultData(basetypes.NewStringNull())
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.ListValueMust(types.StringType, elems),
+					types.StringValue(tt.target),
+				}),
+			}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+			}
+			assertEqual(t, got.ValueString(), tt.want)
+		})
+	}
+}
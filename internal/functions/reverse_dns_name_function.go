@@ -0,0 +1,87 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*reverseDNSNameFunction)(nil)
+
+type reverseDNSNameFunction struct{}
+
+func NewReverseDNSNameFunction() function.Function {
+	return &reverseDNSNameFunction{}
+}
+
+func (f *reverseDNSNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_dns_name"
+}
+
+func (f *reverseDNSNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the in-addr.arpa or ip6.arpa name used for PTR records of an IP address",
+		Description: "10.0.0.1 gives 1.0.0.10.in-addr.arpa and 2001:db8::1 gives 1.0.0.0. ... .8.b.d.0.1.0.0.2.ip6.arpa, with one label per hex digit. " +
+			"A CIDR block gives the name of its reverse zone, so 10.1.0.0/16 gives 1.10.in-addr.arpa; the prefix length must be a multiple of 8 for IPv4 and of 4 for IPv6. " +
+			"Names have no trailing dot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "The IP address or CIDR block",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *reverseDNSNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := ReverseDNSName(ip)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// ReverseDNSName returns the reverse DNS name of an IP address, or of the
+// reverse zone of a CIDR block aligned on a label boundary.
+func ReverseDNSName(s string) (string, error) {
+	p, err := parseCIDROrAddr(s)
+	if err != nil {
+		return "", err
+	}
+
+	raw := p.Addr().AsSlice()
+	var labels []string
+	if p.Addr().Is4() {
+		if p.Bits()%8 != 0 {
+			return "", fmt.Errorf("prefix length of %s must be a multiple of 8 for a reverse zone", p)
+		}
+		for i := p.Bits()/8 - 1; i >= 0; i-- {
+			labels = append(labels, strconv.Itoa(int(raw[i])))
+		}
+		return strings.Join(append(labels, "in-addr", "arpa"), "."), nil
+	}
+
+	if p.Bits()%4 != 0 {
+		return "", fmt.Errorf("prefix length of %s must be a multiple of 4 for a reverse zone", p)
+	}
+	for i := p.Bits()/4 - 1; i >= 0; i-- {
+		nibble := raw[i/2] >> 4
+		if i%2 == 1 {
+			nibble = raw[i/2] & 0x0f
+		}
+		labels = append(labels, strconv.FormatUint(uint64(nibble), 16))
+	}
+	return strings.Join(append(labels, "ip6", "arpa"), "."), nil
+}
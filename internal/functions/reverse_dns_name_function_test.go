@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestReverseDNSName(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "10.0.0.1", want: "1.0.0.10.in-addr.arpa"},
+		{input: "192.0.2.255", want: "255.2.0.192.in-addr.arpa"},
+		{input: "2001:db8::1", want: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+		{input: "10.1.0.0/16", want: "1.10.in-addr.arpa"},
+		{input: "192.0.2.0/24", want: "2.0.192.in-addr.arpa"},
+		{input: "0.0.0.0/0", want: "in-addr.arpa"},
+		{input: "2001:db8::/32", want: "8.b.d.0.1.0.0.2.ip6.arpa"},
+		{input: "2001:db8:a0::/44", want: "a.0.0.8.b.d.0.1.0.0.2.ip6.arpa"},
+		{input: "10.1.0.0/20", wantErr: true},
+		{input: "2001:db8::/34", wantErr: true},
+		{input: "10.0.0.1/8", wantErr: true},
+		{input: "example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ReverseDNSName(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReverseDNSName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ReverseDNSName(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReverseDNSNameFunction_Run(t *testing.T) {
+	f := NewReverseDNSNameFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.1")}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), "1.0.0.10.in-addr.arpa")
+}
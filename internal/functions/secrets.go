@@ -0,0 +1,221 @@
+package functions
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Secret types reported by DetectSecrets.
+const (
+	secretAWSAccessKey       = "aws_access_key"
+	secretGitHubToken        = "github_token"
+	secretJWT                = "jwt"
+	secretPrivateKey         = "private_key"
+	secretSlackWebhook       = "slack_webhook"
+	secretPasswordAssignment = "password_assignment"
+	secretHighEntropy        = "high_entropy"
+)
+
+// secretPattern matches one kind of secret. When Group is non-zero only a
+// submatch is the secret, e.g. the value of a password assignment or the body
+// of a PEM block, so the surrounding text stays readable after redaction. It
+// is the first submatch from Group on that took part in the match, so that
+// alternatives can each capture the secret.
+type secretPattern struct {
+	Type   string
+	Regexp *regexp.Regexp
+	Group  int
+}
+
+var secretPatterns = []secretPattern{
+	{Type: secretPrivateKey, Regexp: regexp.MustCompile(`-----BEGIN[A-Z ]* PRIVATE KEY-----\s*([\s\S]*?)\s*-----END[A-Z ]* PRIVATE KEY-----`), Group: 1},
+	{Type: secretAWSAccessKey, Regexp: regexp.MustCompile(`\b(?:AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16}\b`)},
+	{Type: secretGitHubToken, Regexp: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,255}|github_pat_[A-Za-z0-9_]{22,255})\b`)},
+	{Type: secretJWT, Regexp: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)},
+	{Type: secretSlackWebhook, Regexp: regexp.MustCompile(`https://hooks\.slack\.com/services/T[A-Za-z0-9_]+/B[A-Za-z0-9_]+/[A-Za-z0-9_]+`)},
+	// Key names may carry a prefix, as in DB_PASSWORD or mysql_root_password.
+	// Quoted values run to the closing quote on the same line, so that they
+	// may contain spaces; unquoted values end at whitespace or punctuation.
+	{Type: secretPasswordAssignment, Regexp: regexp.MustCompile(`(?i)(?:password|passwd|pwd|secret|api_?key|access_?token|auth_?token)["']?\s*[:=]\s*(?:"((?:[^"\\\n]|\\.)*)"|'((?:[^'\\\n]|\\.)*)'|["']?([^\s"',;&]+))`), Group: 1},
+}
+
+// highEntropyCandidate matches tokens long enough to be generated secrets.
+var highEntropyCandidate = regexp.MustCompile(`[A-Za-z0-9+/=_-]{20,}`)
+
+// secretTypes lists every type DetectSecrets can report, sorted.
+func secretTypes() []string {
+	names := []string{secretHighEntropy}
+	for _, p := range secretPatterns {
+		names = append(names, p.Type)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SecretFinding is a secret found in text. Start and End are offsets of the
+// secret itself in grapheme clusters, as used by Terraform's substr.
+type SecretFinding struct {
+	Type  string `tfsdk:"type"`
+	Start int    `tfsdk:"start"`
+	End   int    `tfsdk:"end"`
+}
+
+// SecretScanOptions controls DetectSecrets.
+type SecretScanOptions struct {
+	// Types limits detection to the listed secret types. Empty means all.
+	Types []string
+	// EntropyThreshold is the minimum Shannon entropy, in bits per
+	// character, of a high_entropy finding.
+	EntropyThreshold float64
+}
+
+// DefaultSecretScanOptions returns options that detect every secret type.
+func DefaultSecretScanOptions() SecretScanOptions {
+	return SecretScanOptions{EntropyThreshold: 4.0}
+}
+
+// DetectSecrets scans text for credentials. Known formats are matched
+// first; remaining tokens of 20 or more characters that mix letters and
+// digits are reported as high_entropy when their entropy reaches the
+// threshold. Findings never overlap and are ordered by position. A secret
+// that starts or ends inside a grapheme cluster, such as one followed by a
+// combining mark, is widened to the whole cluster.
+func DetectSecrets(text string, opts SecretScanOptions) ([]SecretFinding, error) {
+	spans, err := detectSecretSpans(text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// bounds holds the byte offset at which each grapheme cluster starts,
+	// followed by the length of text.
+	var bounds []int
+	g := uniseg.NewGraphemes(text)
+	for g.Next() {
+		from, _ := g.Positions()
+		bounds = append(bounds, from)
+	}
+	bounds = append(bounds, len(text))
+
+	findings := make([]SecretFinding, 0, len(spans))
+	for _, s := range spans {
+		findings = append(findings, SecretFinding{
+			Type:  s.typ,
+			Start: sort.SearchInts(bounds, s.start+1) - 1,
+			End:   sort.SearchInts(bounds, s.end),
+		})
+	}
+	return findings, nil
+}
+
+// secretSpan is a secret found by detectSecretSpans, with byte offsets.
+type secretSpan struct {
+	typ        string
+	start, end int
+}
+
+// detectSecretSpans does the work of DetectSecrets, returning byte offsets.
+func detectSecretSpans(text string, opts SecretScanOptions) ([]secretSpan, error) {
+	known := secretTypes()
+	for _, t := range opts.Types {
+		if !slices.Contains(known, t) {
+			return nil, fmt.Errorf("unsupported secret type %q, expected one of: %s", t, strings.Join(known, ", "))
+		}
+	}
+	enabled := func(t string) bool {
+		return len(opts.Types) == 0 || slices.Contains(opts.Types, t)
+	}
+
+	var spans []secretSpan
+	overlaps := func(start, end int) bool {
+		for _, s := range spans {
+			if start < s.end && s.start < end {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, p := range secretPatterns {
+		if !enabled(p.Type) {
+			continue
+		}
+		for _, m := range p.Regexp.FindAllStringSubmatchIndex(text, -1) {
+			group := p.Group
+			for group > 0 && 2*group+2 < len(m) && m[2*group] < 0 {
+				group++
+			}
+			start, end := m[2*group], m[2*group+1]
+			if start < 0 || start == end || overlaps(start, end) {
+				continue
+			}
+			spans = append(spans, secretSpan{p.Type, start, end})
+		}
+	}
+
+	if enabled(secretHighEntropy) {
+		for _, m := range highEntropyCandidate.FindAllStringIndex(text, -1) {
+			token := text[m[0]:m[1]]
+			if !strings.ContainsAny(token, "0123456789") || strings.IndexFunc(token, isASCIILetterRune) < 0 {
+				continue
+			}
+			if shannonEntropy(token) < opts.EntropyThreshold || overlaps(m[0], m[1]) {
+				continue
+			}
+			spans = append(spans, secretSpan{secretHighEntropy, m[0], m[1]})
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	return spans, nil
+}
+
+// RedactSecrets replaces every secret DetectSecrets finds in text with
+// MaskWithOptions.
+func RedactSecrets(text string, scan SecretScanOptions, mask MaskOptions) (string, error) {
+	spans, err := detectSecretSpans(text, scan)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	prev := 0
+	for _, s := range spans {
+		masked, err := MaskWithOptions(text[s.start:s.end], mask)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(text[prev:s.start])
+		b.WriteString(masked)
+		prev = s.end
+	}
+	b.WriteString(text[prev:])
+	return b.String(), nil
+}
+
+// shannonEntropy returns the entropy of s in bits per byte.
+func shannonEntropy(s string) float64 {
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+	var entropy float64
+	n := float64(len(s))
+	for _, c := range counts {
+		if c > 0 {
+			p := float64(c) / n
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy
+}
+
+func isASCIILetterRune(r rune) bool {
+	return r < utf8.RuneSelf && isASCIILetter(byte(r))
+}
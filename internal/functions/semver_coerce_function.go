@@ -0,0 +1,170 @@
+package functions
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*semverCoerceFunction)(nil)
+
+type semverCoerceFunction struct{}
+
+func NewSemverCoerceFunction() function.Function {
+	return &semverCoerceFunction{}
+}
+
+func (f *semverCoerceFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_coerce"
+}
+
+func (f *semverCoerceFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Extracts a semantic version from a loosely formatted string, returning null if none is found",
+		Description: "Missing minor and patch components are filled with zero. Options: rightmost (bool) takes the last version in the string instead of the first; include_prerelease (bool) keeps a pre-release suffix that follows a full major.minor.patch.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to extract a version from",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with rightmost and include_prerelease attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *semverCoerceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseSemverCoerceOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, ok := SemverCoerce(input, opts)
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringNull()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// SemverCoerceOptions controls how SemverCoerce picks a version.
+type SemverCoerceOptions struct {
+	// Rightmost selects the version that ends furthest to the right, so
+	// "1.2.3.4" coerces to 2.3.4 instead of 1.2.3.
+	Rightmost bool
+	// IncludePrerelease keeps a "-prerelease" suffix following a complete
+	// major.minor.patch triple.
+	IncludePrerelease bool
+}
+
+func parseSemverCoerceOptions(args []types.Dynamic) (SemverCoerceOptions, error) {
+	opts, err := parseOptions(args, "rightmost", "include_prerelease")
+	if err != nil {
+		return SemverCoerceOptions{}, err
+	}
+	var result SemverCoerceOptions
+	if result.Rightmost, err = opts.Bool("rightmost", false); err != nil {
+		return SemverCoerceOptions{}, err
+	}
+	if result.IncludePrerelease, err = opts.Bool("include_prerelease", false); err != nil {
+		return SemverCoerceOptions{}, err
+	}
+	return result, nil
+}
+
+// SemverCoerce extracts a version from strings such as "v1", "1.2",
+// "1.2.3.4", "release-1.2.3" or "1.2.3_hotfix" and returns it in canonical
+// major.minor.patch form. The boolean is false if s contains no digits.
+func SemverCoerce(s string, opts SemverCoerceOptions) (string, bool) {
+	v, ok := coerceSemver(s, opts)
+	if !ok {
+		return "", false
+	}
+	return v.String(), true
+}
+
+func coerceSemver(s string, opts SemverCoerceOptions) (semver, bool) {
+	var best semver
+	bestEnd := -1
+	for start := 0; start < len(s); start++ {
+		if !isASCIIDigit(s[start]) || (start > 0 && isASCIIDigit(s[start-1])) {
+			continue
+		}
+		v, end, ok := scanSemver(s, start, opts.IncludePrerelease)
+		if !ok {
+			continue
+		}
+		if !opts.Rightmost {
+			return v, true
+		}
+		// Among candidates ending at the same place, the earliest start
+		// covers the most components.
+		if end > bestEnd {
+			best, bestEnd = v, end
+		}
+	}
+	return best, bestEnd != -1
+}
+
+// scanSemver reads up to three dot-separated numeric components starting at
+// s[start]. It returns the version and the index just past it.
+func scanSemver(s string, start int, includePrerelease bool) (semver, int, bool) {
+	var nums [3]int
+	i, n := start, 0
+	for n < 3 {
+		j := i
+		for j < len(s) && isASCIIDigit(s[j]) {
+			j++
+		}
+		if j == i {
+			break
+		}
+		num, err := strconv.Atoi(s[i:j])
+		if err != nil {
+			return semver{}, 0, false
+		}
+		nums[n] = num
+		n++
+		i = j
+		if n < 3 && i+1 < len(s) && s[i] == '.' && isASCIIDigit(s[i+1]) {
+			i++
+			continue
+		}
+		break
+	}
+
+	v := semver{Major: nums[0], Minor: nums[1], Patch: nums[2]}
+	if includePrerelease && n == 3 && i < len(s) && s[i] == '-' {
+		j := i + 1
+		for j < len(s) && isPrereleaseChar(s[j]) {
+			j++
+		}
+		if pre := strings.Trim(s[i+1:j], "."); pre != "" {
+			v.Prerelease = pre
+			i = j
+		}
+	}
+	return v, i, true
+}
+
+func isASCIIDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isPrereleaseChar(c byte) bool {
+	return isASCIIDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '-' || c == '.'
+}
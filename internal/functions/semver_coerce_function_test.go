@@ -0,0 +1,133 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSemverCoerce(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		opts   SemverCoerceOptions
+		want   string
+		wantOK bool
+	}{
+		{"full version", "1.2.3", SemverCoerceOptions{}, "1.2.3", true},
+		{"major minor", "1.2", SemverCoerceOptions{}, "1.2.0", true},
+		{"v prefix major only", "v1", SemverCoerceOptions{}, "1.0.0", true},
+		{"four components", "1.2.3.4", SemverCoerceOptions{}, "1.2.3", true},
+		{"four components rightmost", "1.2.3.4", SemverCoerceOptions{Rightmost: true}, "2.3.4", true},
+		{"release prefix", "release-1.2.3", SemverCoerceOptions{}, "1.2.3", true},
+		{"underscore suffix", "1.2.3_hotfix", SemverCoerceOptions{}, "1.2.3", true},
+		{"ami name", "amzn2-ami-hvm-2.0.20240131.0-x86_64-gp2", SemverCoerceOptions{}, "2.0.0", true},
+		{"ami name leftmost", "ubuntu-jammy-22.04-amd64-server-20240207.1", SemverCoerceOptions{}, "22.4.0", true},
+		{"ami name rightmost", "ubuntu-jammy-22.04-amd64-server-20240207.1", SemverCoerceOptions{Rightmost: true}, "20240207.1.0", true},
+		{"leading zeros", "v01.002.3", SemverCoerceOptions{}, "1.2.3", true},
+		{"trailing dot", "1.", SemverCoerceOptions{}, "1.0.0", true},
+		{"rightmost picks last", "app-1.4.0-build-2.1", SemverCoerceOptions{Rightmost: true}, "2.1.0", true},
+		{"prerelease dropped", "1.2.3-rc.1", SemverCoerceOptions{}, "1.2.3", true},
+		{"prerelease kept", "1.2.3-rc.1", SemverCoerceOptions{IncludePrerelease: true}, "1.2.3-rc.1", true},
+		{"prerelease needs full version", "1.2-rc.1", SemverCoerceOptions{IncludePrerelease: true}, "1.2.0", true},
+		{"build metadata dropped", "1.2.3-rc.1+sha.5114f85", SemverCoerceOptions{IncludePrerelease: true}, "1.2.3-rc.1", true},
+		{"no digits", "latest", SemverCoerceOptions{}, "", false},
+		{"empty", "", SemverCoerceOptions{}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := SemverCoerce(tt.input, tt.opts)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("SemverCoerce(%q, %+v) = (%q, %v), want (%q, %v)", tt.input, tt.opts, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSemverCoerceFunction_Run(t *testing.T) {
+	f := NewSemverCoerceFunction()
+
+	tests := []struct {
+		name     string
+		args     []attr.Value
+		want     string
+		wantNull bool
+		wantErr  bool
+	}{
+		{
+			name: "no options",
+			args: []attr.Value{
+				types.StringValue("release-1.2"),
+				types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+			},
+			want: "1.2.0",
+		},
+		{
+			name: "rightmost",
+			args: []attr.Value{
+				types.StringValue("1.2.3.4"),
+				types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+					types.DynamicValue(types.ObjectValueMust(
+						map[string]attr.Type{"rightmost": types.BoolType},
+						map[string]attr.Value{"rightmost": types.BoolValue(true)},
+					)),
+				}),
+			},
+			want: "2.3.4",
+		},
+		{
+			name: "no version found",
+			args: []attr.Value{
+				types.StringValue("latest"),
+				types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+			},
+			wantNull: true,
+		},
+		{
+			name: "unknown option",
+			args: []attr.Value{
+				types.StringValue("1.2.3"),
+				types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+					types.DynamicValue(types.ObjectValueMust(
+						map[string]attr.Type{"right_most": types.BoolType},
+						map[string]attr.Value{"right_most": types.BoolValue(true)},
+					)),
+				}),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := function.NewResultData(basetypes.NewStringUnknown())
+			req := function.RunRequest{Arguments: function.NewArgumentsData(tt.args)}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+			}
+			if got.IsNull() != tt.wantNull {
+				t.Fatalf("result null = %v, want %v", got.IsNull(), tt.wantNull)
+			}
+			if got.ValueString() != tt.want {
+				t.Errorf("semver_coerce() = %q, want %q", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package functions
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ function.Function = (*semverCompareFunction)(nil)
@@ -23,7 +25,8 @@ func (f *semverCompareFunction) Metadata(_ context.Context, _ function.MetadataR
 
 func (f *semverCompareFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
-		Summary: "Compares two semantic version strings, returning -1, 0, or 1",
+		Summary:     "Compares two semantic version strings, returning -1, 0, or 1",
+		Description: "Options: loose (bool) coerces both inputs as semver_coerce does with include_prerelease set before comparing, so values such as v1.2 or release-1.2.3 are accepted.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "version_a",
@@ -34,18 +37,38 @@ func (f *semverCompareFunction) Definition(_ context.Context, _ function.Definit
 				Description: "The second semantic version",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with a loose attribute",
+		},
 		Return: function.Int64Return{},
 	}
 }
 
 func (f *semverCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var versionA, versionB string
-	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &versionA, &versionB))
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &versionA, &versionB, &optionArgs))
 	if resp.Error != nil {
 		return
 	}
 
-	result, err := SemverCompare(versionA, versionB)
+	opts, err := parseOptions(optionArgs, "loose")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	loose, err := opts.Bool("loose", false)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	compare := SemverCompare
+	if loose {
+		compare = SemverCompareLoose
+	}
+	result, err := compare(versionA, versionB)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
@@ -108,28 +131,92 @@ func SemverCompare(a, b string) (int, error) {
 	if err != nil {
 		return 0, err
 	}
+	return compareSemver(va, vb), nil
+}
 
+// SemverCompareLoose is like SemverCompare but first coerces both versions
+// with SemverCoerce, so partial or decorated strings are accepted.
+func SemverCompareLoose(a, b string) (int, error) {
+	va, ok := coerceSemver(a, SemverCoerceOptions{IncludePrerelease: true})
+	if !ok {
+		return 0, fmt.Errorf("invalid semver %q: no version found", a)
+	}
+	vb, ok := coerceSemver(b, SemverCoerceOptions{IncludePrerelease: true})
+	if !ok {
+		return 0, fmt.Errorf("invalid semver %q: no version found", b)
+	}
+	return compareSemver(va, vb), nil
+}
+
+// compareSemver orders two parsed versions by SemVer precedence.
+func compareSemver(va, vb semver) int {
 	if c := cmpInt(va.Major, vb.Major); c != 0 {
-		return c, nil
+		return c
 	}
 	if c := cmpInt(va.Minor, vb.Minor); c != 0 {
-		return c, nil
+		return c
 	}
 	if c := cmpInt(va.Patch, vb.Patch); c != 0 {
-		return c, nil
+		return c
 	}
 
 	// A version with pre-release has lower precedence than the release version.
 	switch {
 	case va.Prerelease == "" && vb.Prerelease == "":
-		return 0, nil
+		return 0
 	case va.Prerelease == "":
-		return 1, nil
+		return 1
 	case vb.Prerelease == "":
-		return -1, nil
+		return -1
 	default:
-		return strings.Compare(va.Prerelease, vb.Prerelease), nil
+		return comparePrerelease(va.Prerelease, vb.Prerelease)
+	}
+}
+
+// comparePrerelease orders pre-release strings as SemVer §11 requires: the
+// dot-separated identifiers are compared in turn, numerically when both are
+// numeric, and with numeric identifiers below alphanumeric ones. When all
+// shared identifiers are equal, the one with fewer identifiers is lower, so
+// rc.9 < rc.10 and alpha < alpha.1.
+func comparePrerelease(a, b string) int {
+	ia, ib := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(ia) && i < len(ib); i++ {
+		na, aNumeric := prereleaseNumber(ia[i])
+		nb, bNumeric := prereleaseNumber(ib[i])
+		var c int
+		switch {
+		case aNumeric && bNumeric:
+			c = na.Cmp(nb)
+		case aNumeric:
+			c = -1
+		case bNumeric:
+			c = 1
+		default:
+			c = strings.Compare(ia[i], ib[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return cmpInt(len(ia), len(ib))
+}
+
+// prereleaseNumber parses a numeric pre-release identifier. Identifiers
+// may be longer than any integer type, so they are compared as big.Int.
+func prereleaseNumber(id string) (*big.Int, bool) {
+	if id == "" || strings.Trim(id, "0123456789") != "" {
+		return nil, false
+	}
+	n, _ := new(big.Int).SetString(id, 10)
+	return n, true
+}
+
+func (v semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
 	}
+	return s
 }
 
 func cmpInt(a, b int) int {
@@ -27,6 +27,10 @@ func TestSemverCompare(t *testing.T) {
 		{"prerelease less than release", "1.0.0-alpha", "1.0.0", -1, false},
 		{"release greater than prerelease", "1.0.0", "1.0.0-alpha", 1, false},
 		{"prerelease ordering", "1.0.0-alpha", "1.0.0-beta", -1, false},
+		{"prerelease numeric identifiers", "1.0.0-rc.9", "1.0.0-rc.10", -1, false},
+		{"prerelease numeric below alphanumeric", "1.0.0-alpha.1", "1.0.0-alpha.beta", -1, false},
+		{"prerelease fewer identifiers", "1.0.0-alpha", "1.0.0-alpha.1", -1, false},
+		{"prerelease spec order", "1.0.0-beta.11", "1.0.0-rc.1", -1, false},
 		{"v prefix stripped", "v1.2.3", "1.2.3", 0, false},
 		{"build metadata ignored", "1.2.3+build1", "1.2.3+build2", 0, false},
 		{"invalid version", "not.a.ver", "1.0.0", 0, true},
@@ -46,6 +50,34 @@ func TestSemverCompare(t *testing.T) {
 	}
 }
 
+func TestSemverCompareLoose(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    int
+		wantErr bool
+	}{
+		{"partial versions", "1.2", "1.2.0", 0, false},
+		{"v prefix major only", "v2", "1.9.9", 1, false},
+		{"decorated tags", "release-1.2.3", "1.2.3_hotfix", 0, false},
+		{"four components", "1.2.3.4", "1.2.4", -1, false},
+		{"prerelease kept", "1.0.0-rc.1", "1.0.0", -1, false},
+		{"no version", "latest", "1.0.0", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SemverCompareLoose(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SemverCompareLoose(%q, %q) error = %v, wantErr %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("SemverCompareLoose(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestSemverCompareFunction_Run(t *testing.T) {
 	f := NewSemverCompareFunction()
 	ctx := context.Background()
@@ -55,6 +87,7 @@ func TestSemverCompareFunction_Run(t *testing.T) {
 		Arguments: function.NewArgumentsData([]attr.Value{
 			types.StringValue("1.2.3"),
 			types.StringValue("1.3.0"),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
 		}),
 	}
 	resp := function.RunResponse{Result: result}
@@ -73,3 +106,37 @@ func TestSemverCompareFunction_Run(t *testing.T) {
 		t.Errorf("semver_compare(1.2.3, 1.3.0) = %d, want -1", got.ValueInt64())
 	}
 }
+
+func TestSemverCompareFunction_RunLoose(t *testing.T) {
+	f := NewSemverCompareFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewInt64Null())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("v1.3"),
+			types.StringValue("1.2.9"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"loose": types.BoolType},
+					map[string]attr.Value{"loose": types.BoolValue(true)},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.Int64Value)
+	if !ok {
+		t.Fatalf("result is not Int64Value, got %T", resp.Result.Value())
+	}
+	if got.ValueInt64() != 1 {
+		t.Errorf("semver_compare(v1.3, 1.2.9, { loose = true }) = %d, want 1", got.ValueInt64())
+	}
+}
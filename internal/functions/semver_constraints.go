@@ -0,0 +1,346 @@
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// versionBound is one end of a versionInterval. An unbounded lower end
+// extends to the smallest possible version, an unbounded upper end to
+// infinity.
+type versionBound struct {
+	Version   semver
+	Inclusive bool
+	Unbounded bool
+}
+
+type versionInterval struct {
+	Lower versionBound
+	Upper versionBound
+}
+
+// versionSet is a union of sorted, disjoint, non-empty intervals. Versions are
+// treated as a dense order: pre-releases sit between any two releases, so
+// "> 1.0.0, < 1.0.1" is satisfiable by 1.0.1-alpha.
+type versionSet []versionInterval
+
+var anyVersion = versionSet{{
+	Lower: versionBound{Unbounded: true},
+	Upper: versionBound{Unbounded: true},
+}}
+
+// SemverConstraintsIntersect returns the normalized constraint accepted by
+// both a and b. The boolean is false when no version satisfies both. The
+// result may join alternatives with "||", which Terraform does not accept;
+// see versionSet.String.
+func SemverConstraintsIntersect(a, b string) (string, bool, error) {
+	sa, err := parseConstraint(a)
+	if err != nil {
+		return "", false, err
+	}
+	sb, err := parseConstraint(b)
+	if err != nil {
+		return "", false, err
+	}
+
+	result := sa.intersect(sb)
+	if len(result) == 0 {
+		return "", false, nil
+	}
+	return result.String(), true, nil
+}
+
+// SemverConstraintsOverlap reports whether any version satisfies both a and b.
+func SemverConstraintsOverlap(a, b string) (bool, error) {
+	_, ok, err := SemverConstraintsIntersect(a, b)
+	return ok, err
+}
+
+// parseConstraint parses a constraint string in Terraform syntax. Terms
+// separated by "," must all hold; alternatives may be joined with "||".
+// Supported operators are =, !=, >, >=, <, <= and ~>; a bare version is an
+// exact match.
+func parseConstraint(s string) (versionSet, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, fmt.Errorf("invalid constraint: empty string")
+	}
+
+	var result versionSet
+	for _, alt := range strings.Split(s, "||") {
+		set := anyVersion
+		for _, term := range strings.Split(alt, ",") {
+			ts, err := parseConstraintTerm(strings.TrimSpace(term))
+			if err != nil {
+				return nil, err
+			}
+			set = set.intersect(ts)
+		}
+		result = result.union(set)
+	}
+	return result, nil
+}
+
+func parseConstraintTerm(term string) (versionSet, error) {
+	if term == "" {
+		return nil, fmt.Errorf("invalid constraint: empty term")
+	}
+
+	op := "="
+	for _, candidate := range []string{"~>", ">=", "<=", "!=", ">", "<", "="} {
+		if strings.HasPrefix(term, candidate) {
+			op = candidate
+			term = strings.TrimSpace(term[len(candidate):])
+			break
+		}
+	}
+
+	v, parts, err := parseConstraintVersion(term)
+	if err != nil {
+		return nil, err
+	}
+
+	unbounded := versionBound{Unbounded: true}
+	switch op {
+	case "=":
+		return versionSet{{
+			Lower: versionBound{Version: v, Inclusive: true},
+			Upper: versionBound{Version: v, Inclusive: true},
+		}}, nil
+	case "!=":
+		return versionSet{
+			{Lower: unbounded, Upper: versionBound{Version: v}},
+			{Lower: versionBound{Version: v}, Upper: unbounded},
+		}, nil
+	case ">":
+		return versionSet{{Lower: versionBound{Version: v}, Upper: unbounded}}, nil
+	case ">=":
+		return versionSet{{Lower: versionBound{Version: v, Inclusive: true}, Upper: unbounded}}, nil
+	case "<":
+		return versionSet{{Lower: unbounded, Upper: versionBound{Version: v}}}, nil
+	case "<=":
+		return versionSet{{Lower: unbounded, Upper: versionBound{Version: v, Inclusive: true}}}, nil
+	default: // "~>"
+		// The rightmost specified component may increase: ~> 1.2.3 allows
+		// 1.2.x, while ~> 1.2 and ~> 1 allow 1.x.
+		upper := semver{Major: v.Major + 1}
+		if parts == 3 {
+			upper = semver{Major: v.Major, Minor: v.Minor + 1}
+		}
+		return versionSet{{
+			Lower: versionBound{Version: v, Inclusive: true},
+			Upper: versionBound{Version: upper},
+		}}, nil
+	}
+}
+
+// parseConstraintVersion parses a possibly partial version such as "1" or
+// "1.2", filling missing components with zero. It also returns how many
+// components were given.
+func parseConstraintVersion(s string) (semver, int, error) {
+	if s == "" {
+		return semver{}, 0, fmt.Errorf("invalid constraint: missing version")
+	}
+	s = strings.TrimPrefix(s, "v")
+
+	if idx := strings.Index(s, "+"); idx != -1 {
+		s = s[:idx]
+	}
+
+	var pre string
+	if idx := strings.Index(s, "-"); idx != -1 {
+		pre = s[idx+1:]
+		s = s[:idx]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return semver{}, 0, fmt.Errorf("invalid constraint version %q: too many components", s)
+	}
+
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return semver{}, 0, fmt.Errorf("invalid constraint version %q: component %q is not a number", s, p)
+		}
+		nums[i] = n
+	}
+
+	return semver{Major: nums[0], Minor: nums[1], Patch: nums[2], Prerelease: pre}, len(parts), nil
+}
+
+// cmpLower orders lower bounds by where the interval starts.
+func cmpLower(a, b versionBound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return -1
+	case b.Unbounded:
+		return 1
+	}
+	if c := compareSemver(a.Version, b.Version); c != 0 {
+		return c
+	}
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// cmpUpper orders upper bounds by where the interval ends.
+func cmpUpper(a, b versionBound) int {
+	switch {
+	case a.Unbounded && b.Unbounded:
+		return 0
+	case a.Unbounded:
+		return 1
+	case b.Unbounded:
+		return -1
+	}
+	if c := compareSemver(a.Version, b.Version); c != 0 {
+		return c
+	}
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func (iv versionInterval) empty() bool {
+	if iv.Lower.Unbounded || iv.Upper.Unbounded {
+		return false
+	}
+	c := compareSemver(iv.Lower.Version, iv.Upper.Version)
+	return c > 0 || (c == 0 && !(iv.Lower.Inclusive && iv.Upper.Inclusive))
+}
+
+func (s versionSet) intersect(other versionSet) versionSet {
+	var result versionSet
+	for _, a := range s {
+		for _, b := range other {
+			iv := versionInterval{Lower: a.Lower, Upper: a.Upper}
+			if cmpLower(b.Lower, iv.Lower) > 0 {
+				iv.Lower = b.Lower
+			}
+			if cmpUpper(b.Upper, iv.Upper) < 0 {
+				iv.Upper = b.Upper
+			}
+			if !iv.empty() {
+				result = append(result, iv)
+			}
+		}
+	}
+	return result.normalize()
+}
+
+func (s versionSet) union(other versionSet) versionSet {
+	result := make(versionSet, 0, len(s)+len(other))
+	result = append(result, s...)
+	result = append(result, other...)
+	return result.normalize()
+}
+
+// normalize sorts the intervals and merges any that overlap or touch.
+func (s versionSet) normalize() versionSet {
+	if len(s) == 0 {
+		return nil
+	}
+
+	sorted := make(versionSet, len(s))
+	copy(sorted, s)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return cmpLower(sorted[i].Lower, sorted[j].Lower) < 0
+	})
+
+	result := versionSet{sorted[0]}
+	for _, iv := range sorted[1:] {
+		last := &result[len(result)-1]
+		if !last.touches(iv) {
+			result = append(result, iv)
+			continue
+		}
+		if cmpUpper(iv.Upper, last.Upper) > 0 {
+			last.Upper = iv.Upper
+		}
+	}
+	return result
+}
+
+// touches reports whether next, which starts no earlier than iv, overlaps or
+// is adjacent to iv so that the two can be merged.
+func (iv versionInterval) touches(next versionInterval) bool {
+	if iv.Upper.Unbounded || next.Lower.Unbounded {
+		return true
+	}
+	c := compareSemver(next.Lower.Version, iv.Upper.Version)
+	return c < 0 || (c == 0 && (next.Lower.Inclusive || iv.Upper.Inclusive))
+}
+
+// String renders the set as a constraint. Gaps consisting of a single
+// excluded version are written as "!=" terms, keeping the result valid
+// Terraform syntax; any other gap falls back to "||" between the
+// alternatives, which Terraform does not accept.
+func (s versionSet) String() string {
+	if len(s) == 0 {
+		return ""
+	}
+
+	var excluded []string
+	for i := 1; i < len(s); i++ {
+		prev, next := s[i-1].Upper, s[i].Lower
+		if prev.Inclusive || next.Inclusive || compareSemver(prev.Version, next.Version) != 0 {
+			excluded = nil
+			break
+		}
+		excluded = append(excluded, "!= "+prev.Version.String())
+	}
+
+	if len(s) == 1 || excluded != nil {
+		hull := versionInterval{Lower: s[0].Lower, Upper: s[len(s)-1].Upper}
+		return strings.Join(append([]string{hull.String()}, excluded...), ", ")
+	}
+
+	alts := make([]string, len(s))
+	for i, iv := range s {
+		alts[i] = iv.String()
+	}
+	return strings.Join(alts, " || ")
+}
+
+func (iv versionInterval) String() string {
+	if !iv.Lower.Unbounded && !iv.Upper.Unbounded &&
+		compareSemver(iv.Lower.Version, iv.Upper.Version) == 0 {
+		return "= " + iv.Lower.Version.String()
+	}
+
+	var terms []string
+	if !iv.Lower.Unbounded {
+		op := ">"
+		if iv.Lower.Inclusive {
+			op = ">="
+		}
+		terms = append(terms, op+" "+iv.Lower.Version.String())
+	}
+	if !iv.Upper.Unbounded {
+		op := "<"
+		if iv.Upper.Inclusive {
+			op = "<="
+		}
+		terms = append(terms, op+" "+iv.Upper.Version.String())
+	}
+	if len(terms) == 0 {
+		return ">= 0.0.0"
+	}
+	return strings.Join(terms, ", ")
+}
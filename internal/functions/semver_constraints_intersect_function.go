@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*semverConstraintsIntersectFunction)(nil)
+
+type semverConstraintsIntersectFunction struct{}
+
+func NewSemverConstraintsIntersectFunction() function.Function {
+	return &semverConstraintsIntersectFunction{}
+}
+
+func (f *semverConstraintsIntersectFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_constraints_intersect"
+}
+
+func (f *semverConstraintsIntersectFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Intersects two version constraints, returning the normalized result or null when no version satisfies both",
+		Description: "Constraints use Terraform syntax (=, !=, >, >=, <, <=, ~>), with terms separated by commas. As an extension, alternatives may be joined with ||, which Terraform itself does not accept. " +
+			"The result is a single comma-separated constraint whenever the versions both accept can be written that way, with lone excluded versions as != terms. " +
+			"Otherwise its ranges are joined with ||, and it is not a valid Terraform version constraint.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "constraint_a",
+				Description: "The first version constraint",
+			},
+			function.StringParameter{
+				Name:        "constraint_b",
+				Description: "The second version constraint",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *semverConstraintsIntersectFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var constraintA, constraintB string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &constraintA, &constraintB))
+	if resp.Error != nil {
+		return
+	}
+
+	result, ok, err := SemverConstraintsIntersect(constraintA, constraintB)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	if !ok {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.StringNull()))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
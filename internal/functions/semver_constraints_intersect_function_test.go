@@ -0,0 +1,55 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSemverConstraintsIntersectFunction_Run(t *testing.T) {
+	f := NewSemverConstraintsIntersectFunction()
+
+	tests := []struct {
+		name     string
+		a, b     string
+		want     string
+		wantNull bool
+	}{
+		{"overlapping", "~> 1.2", "< 1.5.0", ">= 1.2.0, < 1.5.0", false},
+		{"disjoint", "< 1.0.0", ">= 2.0.0", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := function.NewResultData(basetypes.NewStringUnknown())
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.a),
+					types.StringValue(tt.b),
+				}),
+			}
+			resp := function.RunResponse{Result: result}
+
+			f.Run(context.Background(), req, &resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			got, ok := resp.Result.Value().(basetypes.StringValue)
+			if !ok {
+				t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+			}
+			if got.IsNull() != tt.wantNull {
+				t.Fatalf("result null = %v, want %v", got.IsNull(), tt.wantNull)
+			}
+			if got.ValueString() != tt.want {
+				t.Errorf("semver_constraints_intersect(%q, %q) = %q, want %q", tt.a, tt.b, got.ValueString(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*semverConstraintsOverlapFunction)(nil)
+
+type semverConstraintsOverlapFunction struct{}
+
+func NewSemverConstraintsOverlapFunction() function.Function {
+	return &semverConstraintsOverlapFunction{}
+}
+
+func (f *semverConstraintsOverlapFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_constraints_overlap"
+}
+
+func (f *semverConstraintsOverlapFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks whether any version satisfies both version constraints",
+		Description: "Constraints use Terraform syntax (=, !=, >, >=, <, <=, ~>), with terms separated by commas. As an extension, alternatives may be joined with ||, which Terraform itself does not accept.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "constraint_a",
+				Description: "The first version constraint",
+			},
+			function.StringParameter{
+				Name:        "constraint_b",
+				Description: "The second version constraint",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *semverConstraintsOverlapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var constraintA, constraintB string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &constraintA, &constraintB))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := SemverConstraintsOverlap(constraintA, constraintB)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
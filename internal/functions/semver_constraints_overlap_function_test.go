@@ -0,0 +1,39 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSemverConstraintsOverlapFunction_Run(t *testing.T) {
+	f := NewSemverConstraintsOverlapFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewBoolNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(">= 1.0.0, < 2.0.0"),
+			types.StringValue("~> 1.5"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.BoolValue)
+	if !ok {
+		t.Fatalf("result is not BoolValue, got %T", resp.Result.Value())
+	}
+	if !got.ValueBool() {
+		t.Error("semver_constraints_overlap(>= 1.0.0, < 2.0.0, ~> 1.5) = false, want true")
+	}
+}
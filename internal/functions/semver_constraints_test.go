@@ -0,0 +1,92 @@
+package functions
+
+import "testing"
+
+func TestSemverConstraintsIntersect(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    string
+		wantOK  bool
+		wantErr bool
+	}{
+		{"overlapping ranges", ">= 1.2.0, < 2.0.0", ">= 1.5.0", ">= 1.5.0, < 2.0.0", true, false},
+		{"disjoint ranges", "< 1.0.0", ">= 1.0.0", "", false, false},
+		{"touching inclusive bounds", "<= 1.0.0", ">= 1.0.0", "= 1.0.0", true, false},
+		{"pessimistic patch", "~> 1.2.3", ">= 1.2.5", ">= 1.2.5, < 1.3.0", true, false},
+		{"pessimistic minor", "~> 1.2", "< 1.9.0", ">= 1.2.0, < 1.9.0", true, false},
+		{"pessimistic major", "~> 1", "~> 2", "", false, false},
+		{"bare version exact", "1.4.0", "~> 1.4", "= 1.4.0", true, false},
+		{"exclusion kept", ">= 1.0.0, != 1.5.0", "< 2.0.0", ">= 1.0.0, < 2.0.0, != 1.5.0", true, false},
+		{"exclusion removes exact", "!= 1.5.0", "= 1.5.0", "", false, false},
+		{"multiple exclusions", "!= 1.1.0, != 1.2.0", ">= 1.0.0, <= 1.3.0", ">= 1.0.0, <= 1.3.0, != 1.1.0, != 1.2.0", true, false},
+		{"alternatives", "< 1.0.0 || >= 2.0.0", "> 0.5.0, < 3.0.0", "> 0.5.0, < 1.0.0 || >= 2.0.0, < 3.0.0", true, false},
+		{"alternatives merged", ">= 1.0.0, < 2.0.0 || >= 2.0.0", ">= 0.0.0", ">= 1.0.0", true, false},
+		{"unbounded", ">= 0.0.0", "> 0.0.0 || <= 0.0.0", ">= 0.0.0", true, false},
+		{"prerelease between releases", "> 1.0.0", "< 1.0.1", "> 1.0.0, < 1.0.1", true, false},
+		{"prerelease bound", ">= 2.0.0-beta", "< 2.0.0", ">= 2.0.0-beta, < 2.0.0", true, false},
+		{"prerelease numeric identifiers", ">= 1.0.0-rc.10", "< 1.0.0-rc.9", "", false, false},
+		{"prerelease numeric identifiers overlap", ">= 1.0.0-rc.9", "< 1.0.0-rc.10", ">= 1.0.0-rc.9, < 1.0.0-rc.10", true, false},
+		{"v prefix", ">= v1.0", "<= v1.0.0", "= 1.0.0", true, false},
+		{"no spaces", ">=1.0.0,<2.0.0", "~>1.1", ">= 1.1.0, < 2.0.0", true, false},
+		{"empty constraint", "", ">= 1.0.0", "", false, true},
+		{"empty term", ">= 1.0.0,", ">= 1.0.0", "", false, true},
+		{"invalid version", ">= 1.x", ">= 1.0.0", "", false, true},
+		{"too many components", "= 1.2.3.4", ">= 1.0.0", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := SemverConstraintsIntersect(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SemverConstraintsIntersect(%q, %q) error = %v, wantErr %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("SemverConstraintsIntersect(%q, %q) = (%q, %v), want (%q, %v)", tt.a, tt.b, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestSemverConstraintsIntersect_Normalized(t *testing.T) {
+	// The normalized output must parse back to the same set.
+	got, ok, err := SemverConstraintsIntersect(">= 1.0.0, != 1.5.0, < 2.0.0", "~> 1.0")
+	if err != nil || !ok {
+		t.Fatalf("unexpected result: %q, %v, %v", got, ok, err)
+	}
+	again, ok, err := SemverConstraintsIntersect(got, got)
+	if err != nil || !ok {
+		t.Fatalf("unexpected result: %q, %v, %v", again, ok, err)
+	}
+	assertEqual(t, again, got)
+}
+
+func TestSemverConstraintsOverlap(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		want    bool
+		wantErr bool
+	}{
+		{"overlapping", "~> 1.2", ">= 1.4.0", true, false},
+		{"disjoint", "~> 1.2", ">= 2.0.0", false, false},
+		{"exclusive touch", "< 1.0.0", "> 1.0.0", false, false},
+		{"prerelease numeric identifiers", ">= 1.0.0-rc.10", "< 1.0.0-rc.9", false, false},
+		{"invalid", "~>", ">= 1.0.0", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SemverConstraintsOverlap(tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SemverConstraintsOverlap(%q, %q) error = %v, wantErr %v", tt.a, tt.b, err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("SemverConstraintsOverlap(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
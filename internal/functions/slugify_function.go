@@ -0,0 +1,250 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/text/unicode/norm"
+)
+
+var _ function.Function = (*slugifyFunction)(nil)
+
+type slugifyFunction struct{}
+
+func NewSlugifyFunction() function.Function {
+	return &slugifyFunction{}
+}
+
+func (f *slugifyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slugify"
+}
+
+func (f *slugifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a string to an ASCII slug, transliterating accented and non-Latin characters",
+		Description: "The input is NFKD-normalized, accents are removed and letters without a decomposition (such as ß or Cyrillic) are transliterated. Runs of disallowed characters become a single separator. " +
+			"Options: separator (default \"-\"); lowercase (default true); allowed, a regular expression character class such as \"a-z0-9.\" listing the characters to keep (default a-z0-9, or A-Za-z0-9 when lowercase is false); " +
+			"max_length shortens the result as truncate does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "input",
+				Description: "The string to slugify",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with separator, lowercase, allowed and max_length attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *slugifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input string
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseSlugifyOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result, err := Slugify(input, opts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// SlugifyOptions controls Slugify.
+type SlugifyOptions struct {
+	// Separator replaces each run of disallowed characters.
+	Separator string
+	// Lowercase converts the result to lowercase.
+	Lowercase bool
+	// Allowed is a regular expression character class, without brackets,
+	// of the characters kept in the slug. Empty selects a-z0-9, or A-Za-z0-9
+	// when Lowercase is false.
+	Allowed string
+	// MaxLength shortens the result with TruncateWithOptions, using Separator
+	// before the hash. Zero means no limit.
+	MaxLength int
+}
+
+// DefaultSlugifyOptions returns options for lowercase, dash-separated slugs.
+func DefaultSlugifyOptions() SlugifyOptions {
+	return SlugifyOptions{Separator: "-", Lowercase: true}
+}
+
+func parseSlugifyOptions(args []types.Dynamic) (SlugifyOptions, error) {
+	opts, err := parseOptions(args, "separator", "lowercase", "allowed", "max_length")
+	if err != nil {
+		return SlugifyOptions{}, err
+	}
+
+	result := DefaultSlugifyOptions()
+	if result.Separator, err = opts.String("separator", result.Separator); err != nil {
+		return SlugifyOptions{}, err
+	}
+	if result.Lowercase, err = opts.Bool("lowercase", result.Lowercase); err != nil {
+		return SlugifyOptions{}, err
+	}
+	if result.Allowed, err = opts.String("allowed", result.Allowed); err != nil {
+		return SlugifyOptions{}, err
+	}
+	if result.MaxLength, err = opts.Int("max_length", result.MaxLength); err != nil {
+		return SlugifyOptions{}, err
+	}
+	return result, nil
+}
+
+// Slugify converts s to an ASCII slug, e.g. "Zürich Payments" becomes
+// "zurich-payments" and "São Paulo" becomes "sao-paulo".
+func Slugify(s string, opts SlugifyOptions) (string, error) {
+	if opts.MaxLength < 0 {
+		return "", fmt.Errorf("max_length must not be negative, got %d", opts.MaxLength)
+	}
+
+	allowed := opts.Allowed
+	if allowed == "" {
+		allowed = "a-z0-9"
+		if !opts.Lowercase {
+			allowed = "A-Za-z0-9"
+		}
+	}
+	class, err := regexp.Compile("^[" + allowed + "]$")
+	if err != nil {
+		return "", fmt.Errorf("invalid allowed character class %q: %w", allowed, err)
+	}
+
+	ascii := transliterate(s)
+	if opts.Lowercase {
+		ascii = strings.ToLower(ascii)
+	}
+
+	slug := collapseSlug(ascii, class, opts.Separator)
+	if opts.MaxLength == 0 || len(slug) <= opts.MaxLength {
+		return slug, nil
+	}
+
+	truncateOpts := DefaultTruncateOptions()
+	truncateOpts.Separator = opts.Separator
+	truncated, err := TruncateWithOptions(slug, opts.MaxLength, truncateOpts)
+	if err != nil {
+		return "", err
+	}
+	// The cut may leave a separator, or part of one, right before the hash
+	// suffix. Only the cut text is tidied: the hash may contain the
+	// separator's characters when they are alphanumeric.
+	suffix, err := truncateHashSuffix(slug, truncateOpts)
+	if err != nil {
+		return "", err
+	}
+	if len(truncated) <= len(suffix) || !strings.HasSuffix(truncated, suffix) {
+		// maxLength left no room for the hash, so this is a plain cut.
+		suffix = ""
+	}
+	return trimSlugSeparator(strings.TrimSuffix(truncated, suffix), opts.Separator) + suffix, nil
+}
+
+// trimSlugSeparator removes trailing separators from s, including a
+// separator cut short. The first character of sep never occurs in a slug
+// otherwise, as collapseSlug treats it as a separator.
+func trimSlugSeparator(s, sep string) string {
+	if sep == "" {
+		return s
+	}
+	for strings.HasSuffix(s, sep) {
+		s = strings.TrimSuffix(s, sep)
+	}
+	for i := len(sep) - 1; i > 0; i-- {
+		if strings.HasSuffix(s, sep[:i]) {
+			return strings.TrimSuffix(s, sep[:i])
+		}
+	}
+	return s
+}
+
+// collapseSlug keeps the characters matched by class and replaces each run
+// of other characters with sep, trimming separators from both ends.
+func collapseSlug(s string, class *regexp.Regexp, sep string) string {
+	var b strings.Builder
+	pending := false
+	for _, r := range s {
+		c := string(r)
+		if !class.MatchString(c) || (sep != "" && strings.HasPrefix(sep, c)) {
+			pending = true
+			continue
+		}
+		if pending && b.Len() > 0 {
+			b.WriteString(sep)
+		}
+		pending = false
+		b.WriteString(c)
+	}
+	return b.String()
+}
+
+// transliterate approximates s in ASCII. Characters are decomposed with
+// NFKD and combining marks dropped, so "é" becomes "e" and "ﬁ" becomes
+// "fi"; letters without a decomposition are looked up in a table. Any other
+// non-ASCII character becomes a space so that it still separates words.
+func transliterate(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(s) {
+		switch {
+		case r <= unicode.MaxASCII:
+			b.WriteRune(r)
+		case unicode.Is(unicode.Mn, r):
+		default:
+			if repl, ok := transliterations[r]; ok {
+				b.WriteString(repl)
+			} else {
+				b.WriteByte(' ')
+			}
+		}
+	}
+	return b.String()
+}
+
+// transliterations covers letters that NFKD does not reduce to ASCII.
+var transliterations = map[rune]string{
+	// Latin
+	'ß': "ss", 'ẞ': "SS", 'æ': "ae", 'Æ': "AE", 'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O", 'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D",
+	'þ': "th", 'Þ': "TH", 'ł': "l", 'Ł': "L", 'ħ': "h", 'Ħ': "H",
+	'ı': "i", 'ŋ': "ng", 'Ŋ': "NG", 'ĸ': "k", 'ſ': "s",
+
+	// Greek
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i",
+	'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x",
+	'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y",
+	'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	'Α': "A", 'Β': "V", 'Γ': "G", 'Δ': "D", 'Ε': "E", 'Ζ': "Z", 'Η': "I",
+	'Θ': "TH", 'Ι': "I", 'Κ': "K", 'Λ': "L", 'Μ': "M", 'Ν': "N", 'Ξ': "X",
+	'Ο': "O", 'Π': "P", 'Ρ': "R", 'Σ': "S", 'Τ': "T", 'Υ': "Y",
+	'Φ': "F", 'Χ': "CH", 'Ψ': "PS", 'Ω': "O",
+
+	// Cyrillic
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n",
+	'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y",
+	'ь': "", 'э': "e", 'ю': "yu", 'я': "ya", 'є': "ye", 'і': "i", 'ґ': "g",
+	'А': "A", 'Б': "B", 'В': "V", 'Г': "G", 'Д': "D", 'Е': "E", 'Ж': "ZH",
+	'З': "Z", 'И': "I", 'К': "K", 'Л': "L", 'М': "M", 'Н': "N",
+	'О': "O", 'П': "P", 'Р': "R", 'С': "S", 'Т': "T", 'У': "U", 'Ф': "F",
+	'Х': "KH", 'Ц': "TS", 'Ч': "CH", 'Ш': "SH", 'Щ': "SHCH", 'Ъ': "", 'Ы': "Y",
+	'Ь': "", 'Э': "E", 'Ю': "YU", 'Я': "YA", 'Є': "YE", 'І': "I", 'Ґ': "G",
+}
@@ -0,0 +1,106 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		modify  func(o *SlugifyOptions)
+		want    string
+		wantErr bool
+	}{
+		{name: "umlaut", input: "Zürich Payments", want: "zurich-payments"},
+		{name: "tilde", input: "São Paulo", want: "sao-paulo"},
+		{name: "combining marks", input: "Café Crème", want: "cafe-creme"},
+		{name: "sharp s and ligatures", input: "Straße Œuvre", want: "strasse-oeuvre"},
+		{name: "nordic", input: "Ørsted Ålborg", want: "orsted-alborg"},
+		{name: "polish", input: "Łódź", want: "lodz"},
+		{name: "cyrillic", input: "Москва Сити", want: "moskva-siti"},
+		{name: "greek", input: "Αθήνα", want: "athina"},
+		{name: "compatibility forms", input: "ﬁle Ｎａｍｅ", want: "file-name"},
+		{name: "collapses separators", input: "  --hello__world!!  ", want: "hello-world"},
+		{name: "unknown script separates words", input: "東京Tower", want: "tower"},
+		{name: "empty", input: "", want: ""},
+		{name: "custom separator", input: "Zürich Payments", modify: func(o *SlugifyOptions) { o.Separator = "_" }, want: "zurich_payments"},
+		{name: "no separator", input: "Zürich Payments", modify: func(o *SlugifyOptions) { o.Separator = "" }, want: "zurichpayments"},
+		{name: "keep case", input: "Zürich Payments", modify: func(o *SlugifyOptions) { o.Lowercase = false }, want: "Zurich-Payments"},
+		{name: "allowed dots", input: "api.Zürich.example", modify: func(o *SlugifyOptions) { o.Allowed = "a-z0-9." }, want: "api.zurich.example"},
+		{name: "allowed includes separator", input: "a - - b", modify: func(o *SlugifyOptions) { o.Allowed = "a-z0-9-" }, want: "a-b"},
+		{name: "invalid class", input: "x", modify: func(o *SlugifyOptions) { o.Allowed = "z-a" }, wantErr: true},
+		{
+			name:   "max length",
+			input:  "Zürich Payments Gateway Primary",
+			modify: func(o *SlugifyOptions) { o.MaxLength = 20 },
+			want:   "zurich-paym-b446ab73",
+		},
+		{
+			name:   "max length cut at separator",
+			input:  "Zürich Payments Gateway",
+			modify: func(o *SlugifyOptions) { o.MaxLength = 16 },
+			want:   "zurich-16ac3277",
+		},
+		{
+			name:   "max length with alphanumeric separator keeps hash",
+			input:  "Bacon and bread",
+			modify: func(o *SlugifyOptions) { o.Separator = "a"; o.MaxLength = 12 },
+			want:   "baca7cf8eeda",
+		},
+		{name: "negative max length", input: "x", modify: func(o *SlugifyOptions) { o.MaxLength = -1 }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSlugifyOptions()
+			if tt.modify != nil {
+				tt.modify(&opts)
+			}
+			got, err := Slugify(tt.input, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Slugify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlugifyFunction_Run(t *testing.T) {
+	f := NewSlugifyFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("São Paulo"),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"separator": types.StringType},
+					map[string]attr.Value{"separator": types.StringValue(".")},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "sao.paulo")
+}
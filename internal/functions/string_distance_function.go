@@ -0,0 +1,59 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*stringDistanceFunction)(nil)
+
+type stringDistanceFunction struct{}
+
+func NewStringDistanceFunction() function.Function {
+	return &stringDistanceFunction{}
+}
+
+func (f *stringDistanceFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "string_distance"
+}
+
+func (f *stringDistanceFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Measures how different two strings are",
+		Description: "Strings are compared character by character, case-sensitively. Algorithms: levenshtein counts insertions, deletions and substitutions; " +
+			"damerau_levenshtein also counts swapping two adjacent characters as one edit; hamming counts the positions at which two strings of equal length differ; " +
+			"jaro_winkler returns 1 minus the Jaro-Winkler similarity, from 0 for equal strings to 1 for strings with nothing in common, favouring strings that share a prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The first string",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The second string",
+			},
+			function.StringParameter{
+				Name:        "algorithm",
+				Description: "The algorithm: levenshtein, damerau_levenshtein, jaro_winkler or hamming",
+			},
+		},
+		Return: function.Float64Return{},
+	}
+}
+
+func (f *stringDistanceFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b, algorithm string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b, &algorithm))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := StringDistance(a, b, algorithm)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
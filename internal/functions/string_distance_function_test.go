@@ -0,0 +1,93 @@
+package functions
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestStringDistance(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      string
+		algorithm string
+		want      float64
+		wantErr   bool
+	}{
+		{name: "levenshtein kitten", a: "kitten", b: "sitting", algorithm: "levenshtein", want: 3},
+		{name: "levenshtein equal", a: "same", b: "same", algorithm: "levenshtein", want: 0},
+		{name: "levenshtein empty", a: "", b: "abc", algorithm: "levenshtein", want: 3},
+		{name: "levenshtein transposition", a: "ab", b: "ba", algorithm: "levenshtein", want: 2},
+		{name: "levenshtein counts runes", a: "café", b: "cafe", algorithm: "levenshtein", want: 1},
+		{name: "levenshtein case-sensitive", a: "Prod", b: "prod", algorithm: "levenshtein", want: 1},
+		{name: "damerau transposition", a: "ab", b: "ba", algorithm: "damerau_levenshtein", want: 1},
+		{name: "damerau edit after transposition", a: "ca", b: "abc", algorithm: "damerau_levenshtein", want: 2},
+		{name: "damerau typo", a: "us-esat-1", b: "us-east-1", algorithm: "damerau_levenshtein", want: 1},
+		{name: "damerau empty", a: "abc", b: "", algorithm: "damerau_levenshtein", want: 3},
+		{name: "hamming", a: "karolin", b: "kathrin", algorithm: "hamming", want: 3},
+		{name: "hamming runes", a: "näh", b: "nah", algorithm: "hamming", want: 1},
+		{name: "hamming unequal lengths", a: "abc", b: "ab", algorithm: "hamming", wantErr: true},
+		{name: "jaro-winkler martha", a: "MARTHA", b: "MARHTA", algorithm: "jaro_winkler", want: 1 - 0.9611111},
+		{name: "jaro-winkler dixon", a: "DIXON", b: "DICKSONX", algorithm: "jaro_winkler", want: 1 - 0.8133333},
+		{name: "jaro-winkler equal", a: "abc", b: "abc", algorithm: "jaro_winkler", want: 0},
+		{name: "jaro-winkler nothing shared", a: "abc", b: "xyz", algorithm: "jaro_winkler", want: 1},
+		{name: "jaro-winkler both empty", a: "", b: "", algorithm: "jaro_winkler", want: 0},
+		{name: "jaro-winkler one empty", a: "a", b: "", algorithm: "jaro_winkler", want: 1},
+		{name: "unknown algorithm", a: "a", b: "b", algorithm: "soundex", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StringDistance(tt.a, tt.b, tt.algorithm)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StringDistance() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("StringDistance(%q, %q, %q) = %v, want %v", tt.a, tt.b, tt.algorithm, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringDistanceFunction_Run(t *testing.T) {
+	f := NewStringDistanceFunction()
+
+	result := function.NewResultData(basetypes.NewFloat64Null())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("kitten"),
+			types.StringValue("sitting"),
+			types.StringValue("levenshtein"),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	got, ok := resp.Result.Value().(basetypes.Float64Value)
+	if !ok {
+		t.Fatalf("result is not a Float64Value, got %T", resp.Result.Value())
+	}
+	if got.ValueFloat64() != 3 {
+		t.Errorf("Run() result = %v, want 3", got.ValueFloat64())
+	}
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("soundex"),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewFloat64Null())}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Error("expected error for unknown algorithm")
+	}
+}
@@ -0,0 +1,78 @@
+package functions
+
+import (
+	"fmt"
+	"time"
+	// The time zone database is embedded so that results do not depend on
+	// the zoneinfo files of the machine running Terraform.
+	_ "time/tzdata"
+)
+
+// parseTimestamp parses an RFC 3339 timestamp, the format of Terraform's
+// timestamp() and of the results of the time functions.
+func parseTimestamp(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid RFC 3339 timestamp %q, expected a value such as 2026-01-02T15:04:05Z", s)
+	}
+	return t, nil
+}
+
+// loadLocation loads an IANA time zone, treating an empty name as UTC.
+// Unlike time.LoadLocation, "Local" is rejected since it would make results
+// depend on the machine running Terraform.
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	if name == "Local" {
+		return nil, fmt.Errorf("time zone %q is not supported, use an IANA name such as Europe/Berlin", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone %q, expected an IANA name such as Europe/Berlin", name)
+	}
+	return loc, nil
+}
+
+// wallClock returns the time in loc whose wall clock reads the given date
+// and time. time.Date leaves the choice open around daylight saving time
+// changes, so it is made here: a wall clock time skipped when clocks go
+// forward is moved forward by the length of the gap, as 02:30 becomes 03:30,
+// and one that occurs twice when clocks go back resolves to the first
+// occurrence.
+func wallClock(year int, month time.Month, day, hour, minute, sec, nsec int, loc *time.Location) time.Time {
+	t := time.Date(year, month, day, hour, minute, sec, nsec, loc)
+	wall := time.Date(year, month, day, hour, minute, sec, nsec, time.UTC)
+
+	// Transitions are rarer than one a day, so the offsets in effect a day
+	// either side include both of those around any change at t.
+	var offsets []int
+	for _, probe := range []time.Time{t.AddDate(0, 0, -1), t, t.AddDate(0, 0, 1)} {
+		_, offset := probe.Zone()
+		offsets = append(offsets, offset)
+	}
+
+	var first time.Time
+	found := false
+	for _, offset := range offsets {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(candidate, wall) && (!found || candidate.Before(first)) {
+			first, found = candidate, true
+		}
+	}
+	if found {
+		return first
+	}
+
+	// In a gap, reading the wall clock with the offset from before the
+	// change lands the same distance past it.
+	return wall.Add(-time.Duration(min(offsets[0], offsets[1], offsets[2])) * time.Second).In(loc)
+}
+
+func sameWallClock(t, wall time.Time) bool {
+	y, mo, d := t.Date()
+	wy, wmo, wd := wall.Date()
+	return y == wy && mo == wmo && d == wd &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second() && t.Nanosecond() == wall.Nanosecond()
+}
@@ -0,0 +1,113 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*timeAddCalendarFunction)(nil)
+
+type timeAddCalendarFunction struct{}
+
+func NewTimeAddCalendarFunction() function.Function {
+	return &timeAddCalendarFunction{}
+}
+
+func (f *timeAddCalendarFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_add_calendar"
+}
+
+func (f *timeAddCalendarFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Adds years, months and days to a timestamp by the calendar of a time zone",
+		Description: "Unlike timeadd, which adds a fixed duration, the date is moved on the calendar of the time zone and the time of day is kept, " +
+			"so adding a day across a daylight saving time change moves the timestamp by 23 or 25 hours. " +
+			"Years and months are added first, and if the day does not exist in the resulting month the last day of that month is used, " +
+			"so 2026-01-31 plus one month is 2026-02-28; days are added after that. Negative amounts subtract. " +
+			"A time of day that is skipped by a daylight saving time change is moved forward by the length of the change, so 02:30 becomes 03:30, " +
+			"and one that occurs twice resolves to the first occurrence. " +
+			"Returns an RFC 3339 timestamp in the time zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "The RFC 3339 timestamp to add to, such as the result of timestamp()",
+			},
+			function.Int64Parameter{
+				Name:        "years",
+				Description: "The number of years to add",
+			},
+			function.Int64Parameter{
+				Name:        "months",
+				Description: "The number of months to add",
+			},
+			function.Int64Parameter{
+				Name:        "days",
+				Description: "The number of days to add",
+			},
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "The IANA time zone whose calendar to use, such as Europe/Berlin, or empty for UTC",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *timeAddCalendarFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, timezone string
+	var years, months, days int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &years, &months, &days, &timezone))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	for i, amount := range []struct {
+		name  string
+		value int64
+		limit int64
+	}{
+		{"years", years, maxCalendarYears},
+		{"months", months, maxCalendarYears * 12},
+		{"days", days, maxCalendarYears * 366},
+	} {
+		if amount.value < -amount.limit || amount.value > amount.limit {
+			resp.Error = function.NewArgumentFuncError(int64(i+1), fmt.Sprintf("%s must be between %d and %d, got %d", amount.name, -amount.limit, amount.limit, amount.value))
+			return
+		}
+	}
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(4, err.Error())
+		return
+	}
+
+	result := AddCalendar(t.In(loc), int(years), int(months), int(days))
+	if result.Year() < 0 || result.Year() > 9999 {
+		resp.Error = function.NewFuncError(fmt.Sprintf("the result is in the year %d, which RFC 3339 timestamps cannot represent", result.Year()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.Format(time.RFC3339Nano)))
+}
+
+// maxCalendarYears bounds the amounts time_add_calendar accepts to the span
+// of years RFC 3339 timestamps can represent.
+const maxCalendarYears = 10000
+
+// AddCalendar adds years and months to the date of t in its location,
+// using the last day of the month if the day would not exist, then adds
+// days. The wall clock time of t is kept, as resolved by wallClock.
+func AddCalendar(t time.Time, years, months, days int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year+years, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	day = min(day, daysInMonth(first))
+	return wallClock(first.Year(), first.Month(), day+days, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
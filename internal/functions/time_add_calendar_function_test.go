@@ -0,0 +1,97 @@
+package functions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestAddCalendar(t *testing.T) {
+	tests := []struct {
+		name                string
+		from                string
+		zone                string
+		years, months, days int
+		want                string
+	}{
+		{name: "one month", from: "2026-03-15T10:00:00Z", zone: "UTC", months: 1, want: "2026-04-15T10:00:00Z"},
+		{name: "month end", from: "2026-01-31T10:00:00Z", zone: "UTC", months: 1, want: "2026-02-28T10:00:00Z"},
+		{name: "month end leap year", from: "2028-01-31T10:00:00Z", zone: "UTC", months: 1, want: "2028-02-29T10:00:00Z"},
+		{name: "leap day next year", from: "2028-02-29T10:00:00Z", zone: "UTC", years: 1, want: "2029-02-28T10:00:00Z"},
+		{name: "months then days", from: "2026-01-31T10:00:00Z", zone: "UTC", months: 1, days: 1, want: "2026-03-01T10:00:00Z"},
+		{name: "across years", from: "2026-11-30T00:00:00Z", zone: "UTC", months: 3, want: "2027-02-28T00:00:00Z"},
+		{name: "subtract", from: "2026-03-31T00:00:00Z", zone: "UTC", months: -1, days: -1, want: "2026-02-27T00:00:00Z"},
+		{name: "day across dst keeps time", from: "2026-03-28T12:00:00+01:00", zone: "Europe/Berlin", days: 1, want: "2026-03-29T12:00:00+02:00"},
+		{name: "into skipped hour", from: "2026-03-07T02:30:00-05:00", zone: "America/New_York", days: 1, want: "2026-03-08T03:30:00-04:00"},
+		{name: "into repeated hour", from: "2026-10-31T01:30:00-04:00", zone: "America/New_York", days: 1, want: "2026-11-01T01:30:00-04:00"},
+		{name: "calendar of time zone", from: "2026-01-31T23:30:00Z", zone: "Asia/Tokyo", months: 1, want: "2026-03-01T08:30:00+09:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			from, err := time.Parse(time.RFC3339, tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := AddCalendar(from.In(loc), tt.years, tt.months, tt.days).Format(time.RFC3339)
+			if got != tt.want {
+				t.Errorf("AddCalendar(%s, %d, %d, %d) = %s, want %s", tt.from, tt.years, tt.months, tt.days, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeAddCalendarFunction_Run(t *testing.T) {
+	tests := []struct {
+		name                string
+		timestamp           string
+		years, months, days int64
+		timezone            string
+		want                string
+		wantErr             bool
+	}{
+		{name: "one year", timestamp: "2026-10-19T08:00:00Z", years: 1, want: "2027-10-19T08:00:00Z"},
+		{name: "time zone", timestamp: "2026-10-19T08:00:00Z", days: 7, timezone: "Europe/London", want: "2026-10-26T09:00:00Z"},
+		{name: "invalid timestamp", timestamp: "yesterday", wantErr: true},
+		{name: "amount out of range", timestamp: "2026-10-19T08:00:00Z", months: 1 << 40, wantErr: true},
+		{name: "result out of range", timestamp: "2026-10-19T08:00:00Z", years: 8000, wantErr: true},
+		{name: "unknown time zone", timestamp: "2026-10-19T08:00:00Z", timezone: "Moon/Base", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTimeAddCalendarFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.timestamp),
+					types.Int64Value(tt.years),
+					types.Int64Value(tt.months),
+					types.Int64Value(tt.days),
+					types.StringValue(tt.timezone),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(basetypes.StringValue).ValueString(); got != tt.want {
+				t.Errorf("Run() result = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
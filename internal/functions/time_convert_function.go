@@ -0,0 +1,61 @@
+package functions
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*timeConvertFunction)(nil)
+
+type timeConvertFunction struct{}
+
+func NewTimeConvertFunction() function.Function {
+	return &timeConvertFunction{}
+}
+
+func (f *timeConvertFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_convert"
+}
+
+func (f *timeConvertFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a timestamp to another time zone",
+		Description: "Returns the same instant as an RFC 3339 timestamp with the UTC offset in effect in the time zone at that instant, " +
+			"so that 2026-07-01T12:00:00Z in Europe/Berlin is 2026-07-01T14:00:00+02:00. " +
+			"The time zone database is built in, so this works on hosts without one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "The RFC 3339 timestamp to convert, such as the result of timestamp()",
+			},
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "The IANA time zone to convert to, such as Europe/Berlin, or empty for UTC",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *timeConvertFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, timezone string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &timezone))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, t.In(loc).Format(time.RFC3339Nano)))
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestTimeConvertFunction_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp string
+		timezone  string
+		want      string
+		wantErr   bool
+	}{
+		{name: "summer", timestamp: "2026-07-01T12:00:00Z", timezone: "Europe/Berlin", want: "2026-07-01T14:00:00+02:00"},
+		{name: "winter", timestamp: "2026-01-01T12:00:00Z", timezone: "Europe/Berlin", want: "2026-01-01T13:00:00+01:00"},
+		{name: "half hour offset", timestamp: "2026-01-01T00:00:00Z", timezone: "Asia/Kolkata", want: "2026-01-01T05:30:00+05:30"},
+		{name: "to utc", timestamp: "2026-01-01T00:00:00-08:00", timezone: "", want: "2026-01-01T08:00:00Z"},
+		{name: "fraction kept", timestamp: "2026-01-01T00:00:00.25Z", timezone: "UTC", want: "2026-01-01T00:00:00.25Z"},
+		{name: "invalid timestamp", timestamp: "2026-01-01 00:00", timezone: "UTC", wantErr: true},
+		{name: "unknown time zone", timestamp: "2026-01-01T00:00:00Z", timezone: "Europe/Atlantis", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTimeConvertFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.timestamp),
+					types.StringValue(tt.timezone),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(basetypes.StringValue).ValueString(); got != tt.want {
+				t.Errorf("Run() result = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*timeFormatFunction)(nil)
+
+type timeFormatFunction struct{}
+
+func NewTimeFormatFunction() function.Function {
+	return &timeFormatFunction{}
+}
+
+func (f *timeFormatFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "time_format"
+}
+
+func (f *timeFormatFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Formats a timestamp with a strftime layout in a time zone",
+		Description: "The layout uses the C strftime directives, in English: " +
+			"%Y, %C, %y, %G and %g for years; %m, %B, %b and %h for months; %d, %e and %j for days; %A, %a, %u and %w for weekdays; %U, %W and %V for week numbers; " +
+			"%H, %k, %I, %l, %M, %S, %p and %P for the time of day; %N for nanoseconds, or fewer digits with a width such as %3N; %s for seconds since the Unix epoch; " +
+			"%z, %:z and %Z for the time zone; %F, %D, %T, %R, %r, %c, %x and %X as shorthands; and %n, %t and %% for a newline, a tab and a percent sign. " +
+			"As in GNU date, a - after the % turns off padding, as in %-d, _ pads with spaces and 0 pads with zeros. " +
+			"The timestamp is first converted to the time zone, whose database is built in, so this works on hosts without one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "timestamp",
+				Description: "The RFC 3339 timestamp to format, such as the result of timestamp()",
+			},
+			function.StringParameter{
+				Name:        "layout",
+				Description: "The strftime layout, such as \"%Y-%m-%d %H:%M %Z\"",
+			},
+			function.StringParameter{
+				Name:        "timezone",
+				Description: "The IANA time zone to format in, such as Europe/Berlin, or empty for UTC",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *timeFormatFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, layout, timezone string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &layout, &timezone))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := parseTimestamp(timestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	loc, err := loadLocation(timezone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := Strftime(t.In(loc), layout)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// strftimeShorthands maps the directives that stand for a combination of
+// others to their expansion, using the C locale.
+var strftimeShorthands = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+// Strftime formats t with the strftime directives described in the
+// time_format documentation.
+func Strftime(t time.Time, layout string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(layout); i++ {
+		if layout[i] != '%' {
+			b.WriteByte(layout[i])
+			continue
+		}
+
+		start := i
+		i++
+		var pad byte
+		if i < len(layout) && strings.IndexByte("-_0", layout[i]) >= 0 {
+			pad = layout[i]
+			i++
+		}
+		width := 0
+		for i < len(layout) && layout[i] >= '0' && layout[i] <= '9' {
+			width = width*10 + int(layout[i]-'0')
+			i++
+		}
+		colon := i < len(layout) && layout[i] == ':'
+		if colon {
+			i++
+		}
+		if i == len(layout) {
+			return "", fmt.Errorf("incomplete directive %q at the end of the layout", layout[start:])
+		}
+		directive := layout[i]
+		_, size := utf8.DecodeRuneInString(layout[i:])
+		text := layout[start : i+size]
+		if width > 0 && directive != 'N' {
+			return "", fmt.Errorf("directive %q has a width, which only %%N supports", text)
+		}
+		if colon && directive != 'z' {
+			return "", fmt.Errorf("unsupported directive %q, : is only supported in %%:z", text)
+		}
+
+		if expansion, ok := strftimeShorthands[directive]; ok {
+			s, _ := Strftime(t, expansion)
+			b.WriteString(s)
+			continue
+		}
+		s, ok := strftimeDirective(t, directive, pad, width, colon)
+		if !ok {
+			return "", fmt.Errorf("unsupported directive %q at position %d", text, start)
+		}
+		b.WriteString(s)
+	}
+	return b.String(), nil
+}
+
+// strftimeDirective formats t for a single directive, reporting false if
+// the directive is not supported. Numbers are padded to
+// their usual width with zeros, or with spaces for %e, %k and %l, unless pad
+// asks otherwise.
+func strftimeDirective(t time.Time, directive, pad byte, width int, colon bool) (string, bool) {
+	number := func(n, digits int, fill byte) string {
+		switch pad {
+		case '-':
+			return strconv.Itoa(n)
+		case '_':
+			fill = ' '
+		case '0':
+			fill = '0'
+		}
+		s := strconv.Itoa(n)
+		if len(s) < digits {
+			s = strings.Repeat(string(fill), digits-len(s)) + s
+		}
+		return s
+	}
+	hour12 := t.Hour() % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+	isoYear, isoWeek := t.ISOWeek()
+
+	switch directive {
+	case 'a':
+		return t.Format("Mon"), true
+	case 'A':
+		return t.Format("Monday"), true
+	case 'b', 'h':
+		return t.Format("Jan"), true
+	case 'B':
+		return t.Format("January"), true
+	case 'C':
+		return number(t.Year()/100, 2, '0'), true
+	case 'd':
+		return number(t.Day(), 2, '0'), true
+	case 'e':
+		return number(t.Day(), 2, ' '), true
+	case 'G':
+		return number(isoYear, 4, '0'), true
+	case 'g':
+		return number(isoYear%100, 2, '0'), true
+	case 'H':
+		return number(t.Hour(), 2, '0'), true
+	case 'I':
+		return number(hour12, 2, '0'), true
+	case 'j':
+		return number(t.YearDay(), 3, '0'), true
+	case 'k':
+		return number(t.Hour(), 2, ' '), true
+	case 'l':
+		return number(hour12, 2, ' '), true
+	case 'm':
+		return number(int(t.Month()), 2, '0'), true
+	case 'M':
+		return number(t.Minute(), 2, '0'), true
+	case 'n':
+		return "\n", true
+	case 'N':
+		digits := fmt.Sprintf("%09d", t.Nanosecond())
+		if width > 0 && width < len(digits) {
+			digits = digits[:width]
+		}
+		return digits, true
+	case 'p':
+		return t.Format("PM"), true
+	case 'P':
+		return strings.ToLower(t.Format("PM")), true
+	case 's':
+		return strconv.FormatInt(t.Unix(), 10), true
+	case 'S':
+		return number(t.Second(), 2, '0'), true
+	case 't':
+		return "\t", true
+	case 'u':
+		return strconv.Itoa((int(t.Weekday())+6)%7 + 1), true
+	case 'U':
+		return number((t.YearDay()+6-int(t.Weekday()))/7, 2, '0'), true
+	case 'V':
+		return number(isoWeek, 2, '0'), true
+	case 'w':
+		return strconv.Itoa(int(t.Weekday())), true
+	case 'W':
+		return number((t.YearDay()+6-(int(t.Weekday())+6)%7)/7, 2, '0'), true
+	case 'y':
+		return number(t.Year()%100, 2, '0'), true
+	case 'Y':
+		return number(t.Year(), 4, '0'), true
+	case 'z':
+		if colon {
+			return t.Format("-07:00"), true
+		}
+		return t.Format("-0700"), true
+	case 'Z':
+		return t.Format("MST"), true
+	case '%':
+		return "%", true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,124 @@
+package functions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestStrftime(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A Friday, in the last ISO week of 2026 but the first week of 2027 by
+	// %U and %W.
+	ts := time.Date(2027, 1, 1, 7, 5, 9, 123456789, berlin)
+
+	tests := []struct {
+		layout  string
+		want    string
+		wantErr bool
+	}{
+		{layout: "%Y-%m-%d %H:%M:%S", want: "2027-01-01 07:05:09"},
+		{layout: "%F %T %z %Z", want: "2027-01-01 07:05:09 +0100 CET"},
+		{layout: "%:z", want: "+01:00"},
+		{layout: "%a %A %b %B %h", want: "Fri Friday Jan January Jan"},
+		{layout: "%e|%-d|%_m|%-H|%k|%l|%I %p %P", want: " 1|1| 1|7| 7| 7|07 AM am"},
+		{layout: "%C %y %j %u %w", want: "20 27 001 5 5"},
+		{layout: "%G-W%V %g %U %W", want: "2026-W53 26 00 00"},
+		{layout: "%s.%N %3N", want: "1798783509.123456789 123"},
+		{layout: "%D %R %r", want: "01/01/27 07:05 07:05:09 AM"},
+		{layout: "%c", want: "Fri Jan  1 07:05:09 2027"},
+		{layout: "100%% done%n", want: "100% done\n"},
+		{layout: "plain text", want: "plain text"},
+		{layout: "%Q", wantErr: true},
+		{layout: "%5d", wantErr: true},
+		{layout: "%:H", wantErr: true},
+		{layout: "%é", wantErr: true},
+		{layout: "50%", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			got, err := Strftime(ts, tt.layout)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Strftime(%q) error = %v, wantErr %v", tt.layout, err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assertEqual(t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrftime_WeekNumbers(t *testing.T) {
+	// 2026 starts on a Thursday: the first Sunday is January 4th and the
+	// first Monday January 5th.
+	tests := []struct {
+		day  int
+		want string
+	}{
+		{day: 3, want: "00 00 01"},
+		{day: 4, want: "01 00 01"},
+		{day: 5, want: "01 01 02"},
+		{day: 11, want: "02 01 02"},
+	}
+	for _, tt := range tests {
+		got, err := Strftime(time.Date(2026, 1, tt.day, 0, 0, 0, 0, time.UTC), "%U %W %V")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Strftime(2026-01-%02d) = %q, want %q", tt.day, got, tt.want)
+		}
+	}
+}
+
+func TestTimeFormatFunction_Run(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp string
+		layout    string
+		timezone  string
+		want      string
+		wantErr   bool
+	}{
+		{name: "utc", timestamp: "2026-10-19T08:00:00Z", layout: "%d.%m.%Y %H:%M", want: "19.10.2026 08:00"},
+		{name: "time zone", timestamp: "2026-10-19T08:00:00Z", layout: "%-I:%M %p %Z", timezone: "America/Los_Angeles", want: "1:00 AM PDT"},
+		{name: "invalid layout", timestamp: "2026-10-19T08:00:00Z", layout: "%Q", wantErr: true},
+		{name: "invalid timestamp", timestamp: "1760860800", layout: "%F", wantErr: true},
+		{name: "unknown time zone", timestamp: "2026-10-19T08:00:00Z", layout: "%F", timezone: "PST", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTimeFormatFunction()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.timestamp),
+					types.StringValue(tt.layout),
+					types.StringValue(tt.timezone),
+				}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+			f.Run(context.Background(), req, &resp)
+
+			if (resp.Error != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := resp.Result.Value().(basetypes.StringValue).ValueString(); got != tt.want {
+				t.Errorf("Run() result = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package functions
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWallClock(t *testing.T) {
+	tests := []struct {
+		name   string
+		zone   string
+		year   int
+		month  time.Month
+		day    int
+		hour   int
+		minute int
+		want   string
+	}{
+		{name: "ordinary", zone: "Europe/Berlin", year: 2026, month: 7, day: 1, hour: 12, want: "2026-07-01T12:00:00+02:00"},
+		{name: "skipped hour", zone: "America/New_York", year: 2026, month: 3, day: 8, hour: 2, minute: 30, want: "2026-03-08T03:30:00-04:00"},
+		{name: "repeated hour", zone: "America/New_York", year: 2026, month: 11, day: 1, hour: 1, minute: 30, want: "2026-11-01T01:30:00-04:00"},
+		{name: "half hour change", zone: "Australia/Lord_Howe", year: 2026, month: 10, day: 4, hour: 2, minute: 15, want: "2026-10-04T02:45:00+11:00"},
+		{name: "repeated half hour", zone: "Australia/Lord_Howe", year: 2026, month: 4, day: 5, hour: 1, minute: 45, want: "2026-04-05T01:45:00+11:00"},
+		{name: "normalized day", zone: "UTC", year: 2026, month: 1, day: 32, want: "2026-02-01T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := wallClock(tt.year, tt.month, tt.day, tt.hour, tt.minute, 0, 0, loc).Format(time.RFC3339)
+			if got != tt.want {
+				t.Errorf("wallClock() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadLocation(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "", want: "UTC"},
+		{name: "UTC", want: "UTC"},
+		{name: "Asia/Kolkata", want: "Asia/Kolkata"},
+		{name: "Local", wantErr: true},
+		{name: "Mars/Olympus", wantErr: true},
+		{name: "+02:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadLocation(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadLocation(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("loadLocation(%q) = %s, want %s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
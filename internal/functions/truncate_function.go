@@ -2,10 +2,21 @@ package functions
 
 import (
 	"context"
+	"crypto/sha1"
 	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"math/big"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rivo/uniseg"
 )
 
 var _ function.Function = (*truncateFunction)(nil)
@@ -23,6 +34,9 @@ func (f *truncateFunction) Metadata(_ context.Context, _ function.MetadataReques
 func (f *truncateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
 	resp.Definition = function.Definition{
 		Summary: "Truncates a string to a maximum length, appending a unique hash suffix",
+		Description: "Options: unit (string) selects how length is measured: bytes (the default), runes or graphemes. The result is always valid UTF-8; in bytes mode a multi-byte character is never split. " +
+			"hash_algorithm (sha256, sha1, fnv or crc32), hash_encoding (hex, base32 or base36), hash_length (default 8, 0 disables the hash) and separator (default \"-\") control the uniqueness suffix. " +
+			"position (end, middle or start) selects which part of the input is dropped; the hash is always appended last.",
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:        "input",
@@ -33,6 +47,10 @@ func (f *truncateFunction) Definition(_ context.Context, _ function.DefinitionRe
 				Description: "The maximum allowed length of the output",
 			},
 		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with unit, hash_algorithm, hash_encoding, hash_length, separator and position attributes",
+		},
 		Return: function.StringReturn{},
 	}
 }
@@ -40,12 +58,19 @@ func (f *truncateFunction) Definition(_ context.Context, _ function.DefinitionRe
 func (f *truncateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
 	var input string
 	var maxLength int64
-	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &maxLength))
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input, &maxLength, &optionArgs))
 	if resp.Error != nil {
 		return
 	}
 
-	result, err := Truncate(input, int(maxLength))
+	opts, err := parseTruncateOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := TruncateWithOptions(input, int(maxLength), opts)
 	if err != nil {
 		resp.Error = function.NewFuncError(err.Error())
 		return
@@ -54,26 +79,285 @@ func (f *truncateFunction) Run(ctx context.Context, req function.RunRequest, res
 	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
 }
 
-const hashSuffixLen = 9 // "-" + 8 hex chars
+// Length units accepted by the truncate unit option.
+const (
+	unitBytes     = "bytes"
+	unitRunes     = "runes"
+	unitGraphemes = "graphemes"
+)
+
+// TruncateOptions controls how TruncateWithOptions measures and cuts strings
+// and how the uniqueness suffix is built. Empty strings select the default
+// for Unit, HashAlgorithm, HashEncoding and Position; Separator and
+// HashLength are used as given, so start from DefaultTruncateOptions.
+type TruncateOptions struct {
+	// Unit is one of "bytes" (the default), "runes" or "graphemes".
+	Unit string
+	// HashAlgorithm is one of "sha256" (the default), "sha1", "fnv" or "crc32".
+	HashAlgorithm string
+	// HashEncoding is one of "hex" (the default), "base32" or "base36".
+	// Base32 and base36 output is lowercase.
+	HashEncoding string
+	// HashLength is the number of encoded hash characters to keep. Zero
+	// disables the hash, leaving a plain cut.
+	HashLength int
+	// Separator is placed between the kept text and the hash.
+	Separator string
+	// Position selects which part of the input is dropped: "end" (the
+	// default) keeps the start, "start" keeps the end, and "middle" keeps
+	// both ends joined together. The hash always goes last.
+	Position string
+}
 
-// Truncate shortens s to at most maxLength characters. If truncation is needed
+// DefaultTruncateOptions returns the options used by Truncate: a dash and
+// 8 hex characters of SHA-256, cutting bytes from the end.
+func DefaultTruncateOptions() TruncateOptions {
+	return TruncateOptions{
+		Unit:          unitBytes,
+		HashAlgorithm: "sha256",
+		HashEncoding:  "hex",
+		HashLength:    8,
+		Separator:     "-",
+		Position:      "end",
+	}
+}
+
+func parseTruncateOptions(args []types.Dynamic) (TruncateOptions, error) {
+	opts, err := parseOptions(args, "unit", "hash_algorithm", "hash_encoding", "hash_length", "separator", "position")
+	if err != nil {
+		return TruncateOptions{}, err
+	}
+
+	result := DefaultTruncateOptions()
+	if result.Unit, err = opts.String("unit", result.Unit); err != nil {
+		return TruncateOptions{}, err
+	}
+	if result.HashAlgorithm, err = opts.String("hash_algorithm", result.HashAlgorithm); err != nil {
+		return TruncateOptions{}, err
+	}
+	if result.HashEncoding, err = opts.String("hash_encoding", result.HashEncoding); err != nil {
+		return TruncateOptions{}, err
+	}
+	if result.HashLength, err = opts.Int("hash_length", result.HashLength); err != nil {
+		return TruncateOptions{}, err
+	}
+	if result.Separator, err = opts.String("separator", result.Separator); err != nil {
+		return TruncateOptions{}, err
+	}
+	if result.Position, err = opts.String("position", result.Position); err != nil {
+		return TruncateOptions{}, err
+	}
+	return result, nil
+}
+
+// Truncate shortens s to at most maxLength bytes. If truncation is needed
 // and maxLength is large enough, a dash and 8-character hex hash of the original
 // string is appended to preserve uniqueness. Returns an error if maxLength < 1.
 func Truncate(s string, maxLength int) (string, error) {
+	return TruncateWithOptions(s, maxLength, DefaultTruncateOptions())
+}
+
+// TruncateWithOptions is Truncate with a configurable length unit and hash
+// suffix. The cut is always made on a boundary of the unit, and never inside
+// a multi-byte character, so the result is valid UTF-8 whenever s is. If
+// maxLength cannot fit any text besides the suffix, the hash is omitted.
+func TruncateWithOptions(s string, maxLength int, opts TruncateOptions) (string, error) {
 	if maxLength < 1 {
 		return "", fmt.Errorf("max_length must be at least 1, got %d", maxLength)
 	}
 
-	if len(s) <= maxLength {
+	unit := defaultString(opts.Unit, unitBytes)
+	if err := validateUnit(unit); err != nil {
+		return "", err
+	}
+	position := defaultString(opts.Position, "end")
+	if position != "end" && position != "middle" && position != "start" {
+		return "", fmt.Errorf("unsupported position %q, expected one of: end, middle, start", position)
+	}
+
+	suffix, err := truncateHashSuffix(s, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if stringLength(s, unit) <= maxLength {
 		return s, nil
 	}
 
 	// If maxLength is too small for content + hash suffix, just truncate.
-	if maxLength <= hashSuffixLen {
-		return s[:maxLength], nil
+	suffixLen := stringLength(suffix, unit)
+	if maxLength <= suffixLen {
+		return cutString(s, unit, position, maxLength), nil
+	}
+	return cutString(s, unit, position, maxLength-suffixLen) + suffix, nil
+}
+
+// truncateHashSuffix builds the separator and encoded hash of s, or an empty
+// string when the hash is disabled.
+func truncateHashSuffix(s string, opts TruncateOptions) (string, error) {
+	if opts.HashLength < 0 {
+		return "", fmt.Errorf("hash_length must not be negative, got %d", opts.HashLength)
 	}
 
-	hash := sha256.Sum256([]byte(s))
-	suffix := fmt.Sprintf("-%x", hash[:4])
-	return s[:maxLength-hashSuffixLen] + suffix, nil
+	algorithm := defaultString(opts.HashAlgorithm, "sha256")
+	var digest []byte
+	switch algorithm {
+	case "sha256":
+		sum := sha256.Sum256([]byte(s))
+		digest = sum[:]
+	case "sha1":
+		sum := sha1.Sum([]byte(s))
+		digest = sum[:]
+	case "fnv":
+		h := fnv.New64a()
+		h.Write([]byte(s))
+		digest = h.Sum(nil)
+	case "crc32":
+		digest = binary.BigEndian.AppendUint32(nil, crc32.ChecksumIEEE([]byte(s)))
+	default:
+		return "", fmt.Errorf("unsupported hash_algorithm %q, expected one of: sha256, sha1, fnv, crc32", algorithm)
+	}
+
+	encoding := defaultString(opts.HashEncoding, "hex")
+	var encoded string
+	switch encoding {
+	case "hex":
+		encoded = hex.EncodeToString(digest)
+	case "base32":
+		encoded = strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(digest))
+	case "base36":
+		encoded = encodeBase36(digest)
+	default:
+		return "", fmt.Errorf("unsupported hash_encoding %q, expected one of: hex, base32, base36", encoding)
+	}
+
+	if opts.HashLength > len(encoded) {
+		return "", fmt.Errorf("hash_length %d exceeds the %d characters available from %s with %s encoding", opts.HashLength, len(encoded), algorithm, encoding)
+	}
+	if opts.HashLength == 0 {
+		return "", nil
+	}
+	return opts.Separator + encoded[:opts.HashLength], nil
+}
+
+// encodeBase36 renders b as a lowercase base36 number, zero-padded to the
+// width of the largest value of the same byte length so that output length
+// does not depend on the input.
+func encodeBase36(b []byte) string {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8))
+	width := len(limit.Sub(limit, big.NewInt(1)).Text(36))
+	s := new(big.Int).SetBytes(b).Text(36)
+	return strings.Repeat("0", width-len(s)) + s
+}
+
+// cutString shortens s to at most n units, dropping text at the given
+// position.
+func cutString(s, unit, position string, n int) string {
+	switch position {
+	case "start":
+		return stringSuffix(s, unit, n)
+	case "middle":
+		head := (n + 1) / 2
+		return stringPrefix(s, unit, head) + stringSuffix(s, unit, n-head)
+	default:
+		return stringPrefix(s, unit, n)
+	}
+}
+
+func defaultString(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func validateUnit(unit string) error {
+	switch unit {
+	case unitBytes, unitRunes, unitGraphemes:
+		return nil
+	default:
+		return fmt.Errorf("unsupported unit %q, expected one of: bytes, runes, graphemes", unit)
+	}
+}
+
+// stringLength measures s in the given unit.
+func stringLength(s, unit string) int {
+	switch unit {
+	case unitRunes:
+		return utf8.RuneCountInString(s)
+	case unitGraphemes:
+		return uniseg.GraphemeClusterCount(s)
+	default:
+		return len(s)
+	}
+}
+
+// splitString breaks s into its runes or grapheme clusters.
+func splitString(s, unit string) []string {
+	var parts []string
+	if unit == unitGraphemes {
+		g := uniseg.NewGraphemes(s)
+		for g.Next() {
+			parts = append(parts, g.Str())
+		}
+		return parts
+	}
+	for _, r := range s {
+		parts = append(parts, string(r))
+	}
+	return parts
+}
+
+// stringPrefix returns the longest prefix of s that is at most n units long
+// and ends on a unit boundary. In bytes mode the prefix never ends inside a
+// multi-byte character, so it may be shorter than n.
+func stringPrefix(s, unit string, n int) string {
+	switch unit {
+	case unitRunes:
+		count := 0
+		for i := range s {
+			if count == n {
+				return s[:i]
+			}
+			count++
+		}
+		return s
+	case unitGraphemes:
+		count, end := 0, 0
+		g := uniseg.NewGraphemes(s)
+		for count < n && g.Next() {
+			_, end = g.Positions()
+			count++
+		}
+		return s[:end]
+	default:
+		if n >= len(s) {
+			return s
+		}
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		return s[:n]
+	}
+}
+
+// stringSuffix returns the longest suffix of s that is at most n units long
+// and starts on a unit boundary.
+func stringSuffix(s, unit string, n int) string {
+	if unit == unitBytes {
+		if n >= len(s) {
+			return s
+		}
+		i := len(s) - n
+		for i < len(s) && !utf8.RuneStart(s[i]) {
+			i++
+		}
+		return s[i:]
+	}
+
+	total := stringLength(s, unit)
+	if n >= total {
+		return s
+	}
+	return s[len(stringPrefix(s, unit, total-n)):]
 }
@@ -2,7 +2,16 @@ package functions
 
 import (
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"hash/fnv"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -84,6 +93,220 @@ func TestTruncate(t *testing.T) {
 	}
 }
 
+func TestTruncateWithOptions_Units(t *testing.T) {
+	const (
+		accented  = "caf\u00e9-caf\u00e9-caf\u00e9-caf\u00e9"          // é is 2 bytes
+		combining = "cafe\u0301-cafe\u0301-cafe\u0301-cafe\u0301"      // e + combining acute
+		cjk       = "\u6771\u4eac\u90fd\u6e2f\u533a\u516d\u672c\u6728" // 3 bytes each
+		family    = "\U0001F468\u200D\U0001F469\u200D\U0001F467"       // one grapheme, 5 runes
+	)
+
+	tests := []struct {
+		name      string
+		input     string
+		maxLength int
+		unit      string
+		want      string
+		wantLen   int
+		wantErr   bool
+	}{
+		{name: "bytes does not split two-byte rune", input: "caf\u00e9", maxLength: 4, unit: "bytes", want: "caf"},
+		{name: "default unit is bytes", input: "caf\u00e9", maxLength: 4, want: "caf"},
+		{name: "bytes does not split cjk rune", input: cjk, maxLength: 8, unit: "bytes", want: "\u6771\u4eac"},
+		{name: "runes counts characters", input: cjk, maxLength: 8, unit: "runes", want: cjk},
+		{name: "runes cut", input: cjk, maxLength: 3, unit: "runes", want: "\u6771\u4eac\u90fd"},
+		{name: "runes splits combining mark", input: "cafe\u0301", maxLength: 4, unit: "runes", want: "cafe"},
+		{name: "graphemes keeps combining mark", input: "cafe\u0301s", maxLength: 4, unit: "graphemes", want: "cafe\u0301"},
+		{name: "graphemes keeps emoji sequence", input: family + family, maxLength: 1, unit: "graphemes", want: family},
+		{name: "runes splits emoji sequence", input: family, maxLength: 2, unit: "runes", want: "\U0001F468\u200D"},
+		{name: "bytes drops partial emoji", input: family, maxLength: 6, unit: "bytes", want: "\U0001F468"},
+		{name: "flag emoji", input: "\U0001F1E9\U0001F1EA\U0001F1EB\U0001F1F7", maxLength: 1, unit: "graphemes", want: "\U0001F1E9\U0001F1EA"},
+		{name: "hash suffix bytes", input: accented, maxLength: 16, unit: "bytes", wantLen: 16},
+		{name: "hash suffix runes", input: accented, maxLength: 16, unit: "runes", wantLen: 16},
+		{name: "hash suffix graphemes", input: combining, maxLength: 16, unit: "graphemes", wantLen: 16},
+		{name: "unsupported unit", input: "x", maxLength: 5, unit: "words", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultTruncateOptions()
+			opts.Unit = tt.unit
+			got, err := TruncateWithOptions(tt.input, tt.maxLength, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TruncateWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !utf8.ValidString(got) {
+				t.Fatalf("result %q is not valid UTF-8", got)
+			}
+			if tt.want != "" {
+				assertEqual(t, got, tt.want)
+			}
+			if tt.wantLen != 0 {
+				if n := stringLength(got, tt.unit); n != tt.wantLen {
+					t.Errorf("length in %s = %d, want %d (%q)", tt.unit, n, tt.wantLen, got)
+				}
+				if got[len(got)-9] != '-' {
+					t.Errorf("expected hash suffix, got %q", got)
+				}
+			}
+		})
+	}
+}
+
+func TestTruncateWithOptions_Hash(t *testing.T) {
+	const input = "payments-service-eu-west-1-production"
+
+	sha256Sum := sha256.Sum256([]byte(input))
+	sha256Hex := hex.EncodeToString(sha256Sum[:])
+	sha1Sum := sha1.Sum([]byte(input))
+	sha1Base32 := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sha1Sum[:]))
+	fnvHash := fnv.New64a()
+	fnvHash.Write([]byte(input))
+	crc32Hex := fmt.Sprintf("%08x", crc32.ChecksumIEEE([]byte(input)))
+
+	tests := []struct {
+		name      string
+		maxLength int
+		modify    func(o *TruncateOptions)
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:      "defaults match Truncate",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) {},
+			want:      "payments-se-" + sha256Hex[:8],
+		},
+		{
+			name:      "no separator",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.Separator = "" },
+			want:      "payments-ser" + sha256Hex[:8],
+		},
+		{
+			name:      "custom separator and length",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.Separator = "_"; o.HashLength = 4 },
+			want:      "payments-servic_" + sha256Hex[:4],
+		},
+		{
+			name:      "sha1 base32",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashAlgorithm = "sha1"; o.HashEncoding = "base32"; o.HashLength = 6 },
+			want:      "payments-serv-" + sha1Base32[:6],
+		},
+		{
+			name:      "fnv base36",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashAlgorithm = "fnv"; o.HashEncoding = "base36" },
+			want:      "payments-se-" + encodeBase36(fnvHash.Sum(nil))[:8],
+		},
+		{
+			name:      "crc32 full length",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashAlgorithm = "crc32" },
+			want:      "payments-se-" + crc32Hex,
+		},
+		{
+			name:      "hash disabled",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashLength = 0 },
+			want:      "payments-service-eu-",
+		},
+		{
+			name:      "middle keeps environment suffix",
+			maxLength: 28,
+			modify:    func(o *TruncateOptions) { o.Position = "middle" },
+			want:      "payments-s" + "roduction-" + sha256Hex[:8],
+		},
+		{
+			name:      "start keeps the end",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.Position = "start" },
+			want:      "-production-" + sha256Hex[:8],
+		},
+		{
+			name:      "middle without room for hash",
+			maxLength: 5,
+			modify:    func(o *TruncateOptions) { o.Position = "middle" },
+			want:      "payon",
+		},
+		{
+			name:      "crc32 length too long",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashAlgorithm = "crc32"; o.HashLength = 9 },
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported algorithm",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashAlgorithm = "md5" },
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported encoding",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashEncoding = "base64" },
+			wantErr:   true,
+		},
+		{
+			name:      "unsupported position",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.Position = "left" },
+			wantErr:   true,
+		},
+		{
+			name:      "negative hash length",
+			maxLength: 20,
+			modify:    func(o *TruncateOptions) { o.HashLength = -1 },
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultTruncateOptions()
+			tt.modify(&opts)
+			got, err := TruncateWithOptions(input, tt.maxLength, opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TruncateWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			assertEqual(t, got, tt.want)
+			if len(got) > tt.maxLength {
+				t.Errorf("len = %d, exceeds %d", len(got), tt.maxLength)
+			}
+		})
+	}
+}
+
+func TestEncodeBase36(t *testing.T) {
+	assertEqual(t, encodeBase36([]byte{0, 0, 0, 0}), "0000000")
+	assertEqual(t, encodeBase36([]byte{0xff, 0xff, 0xff, 0xff}), "1z141z3")
+	assertEqual(t, encodeBase36([]byte{0, 0, 0, 35}), "000000z")
+}
+
+func TestTruncate_NeverInvalidUTF8(t *testing.T) {
+	input := strings.Repeat("\u00e9\u6771\U0001F600", 10)
+	for maxLength := 1; maxLength <= len(input); maxLength++ {
+		got, err := Truncate(input, maxLength)
+		if err != nil {
+			t.Fatalf("Truncate(%d) unexpected error: %s", maxLength, err)
+		}
+		if !utf8.ValidString(got) {
+			t.Fatalf("Truncate(%d) = %q is not valid UTF-8", maxLength, got)
+		}
+		if len(got) > maxLength {
+			t.Fatalf("Truncate(%d) len = %d, exceeds limit", maxLength, len(got))
+		}
+	}
+}
+
 func assertEqual(t *testing.T, got, want string) {
 	t.Helper()
 	if got != want {
@@ -100,6 +323,7 @@ func TestTruncateFunction_Run(t *testing.T) {
 		Arguments: function.NewArgumentsData([]attr.Value{
 			types.StringValue("my-very-long-resource-name-that-exceeds-the-limit"),
 			types.Int64Value(24),
+			types.TupleValueMust([]attr.Type{}, []attr.Value{}),
 		}),
 	}
 	resp := function.RunResponse{Result: result}
@@ -118,3 +342,35 @@ func TestTruncateFunction_Run(t *testing.T) {
 		t.Errorf("len = %d, want 24", len(got.ValueString()))
 	}
 }
+
+func TestTruncateFunction_RunUnit(t *testing.T) {
+	f := NewTruncateFunction()
+	ctx := context.Background()
+
+	result := function.NewResultData(basetypes.NewStringNull())
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("\u6771\u4eac\u90fd\u6e2f\u533a"),
+			types.Int64Value(3),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"unit": types.StringType},
+					map[string]attr.Value{"unit": types.StringValue("runes")},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: result}
+
+	f.Run(ctx, req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+
+	got, ok := resp.Result.Value().(basetypes.StringValue)
+	if !ok {
+		t.Fatalf("result is not StringValue, got %T", resp.Result.Value())
+	}
+	assertEqual(t, got.ValueString(), "\u6771\u4eac\u90fd")
+}
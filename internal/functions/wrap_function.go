@@ -0,0 +1,186 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/rivo/uniseg"
+)
+
+var _ function.Function = (*wrapFunction)(nil)
+
+type wrapFunction struct{}
+
+func NewWrapFunction() function.Function {
+	return &wrapFunction{}
+}
+
+func (f *wrapFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "wrap"
+}
+
+func (f *wrapFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Word-wraps text to a maximum line width",
+		Description: "Width is measured in terminal columns, so East Asian wide characters and most emoji count as two. " +
+			"Blank lines separate paragraphs and are kept; the lines within a paragraph are joined and refilled, breaking only at whitespace. " +
+			"Words longer than a line are left on a line of their own. " +
+			"Options: indent (default none) is prepended to every non-blank line and counts towards the width; " +
+			"break_long_words splits words longer than a line at character boundaries, except URLs, which are never broken.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "text",
+				Description: "The text to wrap",
+			},
+			function.Int64Parameter{
+				Name:        "width",
+				Description: "The maximum width of a line, in columns",
+			},
+		},
+		VariadicParameter: function.DynamicParameter{
+			Name:        "options",
+			Description: "An optional object with indent and break_long_words attributes",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *wrapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	var width int64
+	var optionArgs []types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text, &width, &optionArgs))
+	if resp.Error != nil {
+		return
+	}
+
+	opts, err := parseWrapOptions(optionArgs)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	result, err := Wrap(text, int(width), opts)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// WrapOptions controls Wrap.
+type WrapOptions struct {
+	// Indent is prepended to every non-blank line.
+	Indent string
+	// BreakLongWords splits words that do not fit on a line by themselves.
+	// URLs are never split.
+	BreakLongWords bool
+}
+
+func parseWrapOptions(args []types.Dynamic) (WrapOptions, error) {
+	opts, err := parseOptions(args, "indent", "break_long_words")
+	if err != nil {
+		return WrapOptions{}, err
+	}
+
+	var result WrapOptions
+	if result.Indent, err = opts.String("indent", result.Indent); err != nil {
+		return WrapOptions{}, err
+	}
+	if result.BreakLongWords, err = opts.Bool("break_long_words", result.BreakLongWords); err != nil {
+		return WrapOptions{}, err
+	}
+	return result, nil
+}
+
+// Wrap fills each paragraph of text into lines at most width columns wide,
+// greedily placing as many words as fit on each line. Paragraphs are runs of
+// non-blank lines; blank lines between them are kept as empty lines.
+func Wrap(text string, width int, opts WrapOptions) (string, error) {
+	available := width - uniseg.StringWidth(opts.Indent)
+	if available < 1 {
+		return "", fmt.Errorf("width must leave room for at least one column after the indent, got %d", width)
+	}
+
+	var lines, paragraph []string
+	flush := func() {
+		for _, line := range fillParagraph(strings.Fields(strings.Join(paragraph, " ")), available, opts.BreakLongWords) {
+			lines = append(lines, opts.Indent+line)
+		}
+		paragraph = nil
+	}
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			flush()
+			lines = append(lines, "")
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	flush()
+	return strings.Join(lines, "\n"), nil
+}
+
+// fillParagraph greedily packs words into lines of at most width columns.
+func fillParagraph(words []string, width int, breakLongWords bool) []string {
+	var lines []string
+	var line strings.Builder
+	lineWidth := 0
+	for _, word := range words {
+		wordWidth := uniseg.StringWidth(word)
+		if lineWidth > 0 && lineWidth+1+wordWidth <= width {
+			line.WriteByte(' ')
+			line.WriteString(word)
+			lineWidth += 1 + wordWidth
+			continue
+		}
+		if lineWidth > 0 {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineWidth = 0
+		}
+
+		if wordWidth > width && breakLongWords && !isURL(word) {
+			pieces := splitByWidth(word, width)
+			lines = append(lines, pieces[:len(pieces)-1]...)
+			word = pieces[len(pieces)-1]
+			wordWidth = uniseg.StringWidth(word)
+		}
+		line.WriteString(word)
+		lineWidth = wordWidth
+	}
+	if lineWidth > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// splitByWidth cuts s at grapheme cluster boundaries into pieces of at most
+// width columns. A cluster wider than width gets a piece of its own.
+func splitByWidth(s string, width int) []string {
+	var pieces []string
+	var piece strings.Builder
+	pieceWidth := 0
+	state := -1
+	for s != "" {
+		var cluster string
+		var w int
+		cluster, s, w, state = uniseg.FirstGraphemeClusterInString(s, state)
+		if pieceWidth > 0 && pieceWidth+w > width {
+			pieces = append(pieces, piece.String())
+			piece.Reset()
+			pieceWidth = 0
+		}
+		piece.WriteString(cluster)
+		pieceWidth += w
+	}
+	return append(pieces, piece.String())
+}
+
+func isURL(word string) bool {
+	return strings.Contains(word, "://") || strings.HasPrefix(strings.ToLower(word), "www.")
+}
@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		width   int
+		opts    WrapOptions
+		want    string
+		wantErr bool
+	}{
+		{name: "fits", text: "hello world", width: 20, want: "hello world"},
+		{name: "exact fit", text: "hello world", width: 11, want: "hello world"},
+		{name: "wraps", text: "the quick brown fox jumps over the lazy dog", width: 10, want: "the quick\nbrown fox\njumps over\nthe lazy\ndog"},
+		{name: "refills paragraph", text: "one\ntwo   three\n\tfour", width: 9, want: "one two\nthree\nfour"},
+		{name: "keeps paragraphs", text: "aaa bbb\n\nccc ddd", width: 3, want: "aaa\nbbb\n\nccc\nddd"},
+		{name: "keeps blank line runs", text: "a\n\n\nb\n", width: 5, want: "a\n\n\nb\n"},
+		{name: "whitespace-only line ends paragraph", text: "a\n  \nb", width: 5, want: "a\n\nb"},
+		{name: "crlf", text: "a b\r\nc", width: 80, want: "a b c"},
+		{name: "wide characters", text: "日本語 テキスト", width: 8, want: "日本語\nテキスト"},
+		{name: "emoji", text: "👋🏽 hi there", width: 5, want: "👋🏽 hi\nthere"},
+		{name: "long word overflows", text: "a abcdefghij b", width: 4, want: "a\nabcdefghij\nb"},
+		{name: "break long words", text: "a abcdefghij b", width: 4, opts: WrapOptions{BreakLongWords: true}, want: "a\nabcd\nefgh\nij b"},
+		{name: "break wide long word", text: "日本語テキスト", width: 5, opts: WrapOptions{BreakLongWords: true}, want: "日本\n語テ\nキス\nト"},
+		{name: "url never broken", text: "see https://example.com/a/very/long/path", width: 10, opts: WrapOptions{BreakLongWords: true}, want: "see\nhttps://example.com/a/very/long/path"},
+		{name: "indent", text: "alpha beta gamma", width: 12, opts: WrapOptions{Indent: "  "}, want: "  alpha beta\n  gamma"},
+		{name: "indent skips blank lines", text: "a\n\nb", width: 12, opts: WrapOptions{Indent: "# "}, want: "# a\n\n# b"},
+		{name: "empty", text: "", width: 10, want: ""},
+		{name: "zero width", text: "a", width: 0, wantErr: true},
+		{name: "indent fills width", text: "a", width: 2, opts: WrapOptions{Indent: "  "}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Wrap(tt.text, tt.width, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Wrap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.text, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrapFunction_Run(t *testing.T) {
+	f := NewWrapFunction()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("alpha beta gamma"),
+			types.Int64Value(12),
+			types.TupleValueMust([]attr.Type{types.DynamicType}, []attr.Value{
+				types.DynamicValue(types.ObjectValueMust(
+					map[string]attr.Type{"indent": types.StringType},
+					map[string]attr.Value{"indent": types.StringValue("> ")},
+				)),
+			}),
+		}),
+	}
+	resp := function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+
+	f.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	assertEqual(t, resp.Result.Value().(basetypes.StringValue).ValueString(), "> alpha beta\n> gamma")
+
+	req.Arguments = function.NewArgumentsData([]attr.Value{
+		types.StringValue("a"),
+		types.Int64Value(0),
+		types.TupleValueMust([]attr.Type{}, []attr.Value{}),
+	})
+	resp = function.RunResponse{Result: function.NewResultData(basetypes.NewStringNull())}
+	f.Run(context.Background(), req, &resp)
+	if resp.Error == nil {
+		t.Error("expected error for zero width")
+	}
+}
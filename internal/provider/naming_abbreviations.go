@@ -0,0 +1,95 @@
+package provider
+
+// defaultRegionAbbreviations maps cloud regions to the short codes used by
+// the {region_abbr} placeholder of the manta_naming data source. Codes are
+// unique across clouds so that generated names are too. Entries can be
+// added or replaced with the provider's region_abbreviations attribute.
+var defaultRegionAbbreviations = map[string]string{
+	// AWS
+	"us-east-1":      "use1",
+	"us-east-2":      "use2",
+	"us-west-1":      "usw1",
+	"us-west-2":      "usw2",
+	"ca-central-1":   "cac1",
+	"sa-east-1":      "sae1",
+	"eu-west-1":      "euw1",
+	"eu-west-2":      "euw2",
+	"eu-west-3":      "euw3",
+	"eu-central-1":   "euc1",
+	"eu-central-2":   "euc2",
+	"eu-north-1":     "eun1",
+	"eu-south-1":     "eus1",
+	"ap-east-1":      "ape1",
+	"ap-south-1":     "aps1",
+	"ap-northeast-1": "apne1",
+	"ap-northeast-2": "apne2",
+	"ap-northeast-3": "apne3",
+	"ap-southeast-1": "apse1",
+	"ap-southeast-2": "apse2",
+	"me-south-1":     "mes1",
+	"af-south-1":     "afs1",
+
+	// Azure
+	"eastus":             "eus",
+	"eastus2":            "eus2",
+	"westus":             "wus",
+	"westus2":            "wus2",
+	"westus3":            "wus3",
+	"centralus":          "cus",
+	"northcentralus":     "ncus",
+	"southcentralus":     "scus",
+	"canadacentral":      "cac",
+	"canadaeast":         "cae",
+	"brazilsouth":        "brs",
+	"northeurope":        "neu",
+	"westeurope":         "weu",
+	"uksouth":            "uks",
+	"ukwest":             "ukw",
+	"francecentral":      "frc",
+	"germanywestcentral": "gwc",
+	"swedencentral":      "sdc",
+	"switzerlandnorth":   "szn",
+	"norwayeast":         "nwe",
+	"eastasia":           "ea",
+	"southeastasia":      "sea",
+	"japaneast":          "jpe",
+	"japanwest":          "jpw",
+	"koreacentral":       "krc",
+	"centralindia":       "inc",
+	"australiaeast":      "aue",
+	"australiasoutheast": "ause",
+
+	// GCP. Codes start with "g" so that they do not collide with those of
+	// similarly named AWS regions, such as us-east-1 and us-east1.
+	"us-central1":             "gusc1",
+	"us-east1":                "guse1",
+	"us-east4":                "guse4",
+	"us-west1":                "gusw1",
+	"us-west2":                "gusw2",
+	"northamerica-northeast1": "gnane1",
+	"southamerica-east1":      "gsae1",
+	"europe-west1":            "geuw1",
+	"europe-west2":            "geuw2",
+	"europe-west3":            "geuw3",
+	"europe-west4":            "geuw4",
+	"europe-north1":           "geun1",
+	"asia-east1":              "gase1",
+	"asia-northeast1":         "gasne1",
+	"asia-southeast1":         "gasse1",
+	"asia-south1":             "gaso1",
+	"australia-southeast1":    "gause1",
+}
+
+// defaultResourceAbbreviations maps the resource types accepted by
+// manta_naming to the short codes used by the {resource_abbr} placeholder.
+// Entries can be replaced with the provider's resource_abbreviations
+// attribute.
+var defaultResourceAbbreviations = map[string]string{
+	"aws_iam_role":          "role",
+	"azure_key_vault":       "kv",
+	"azure_storage_account": "st",
+	"gcp_project_id":        "prj",
+	"k8s_dns_label":         "k8s",
+	"k8s_dns_subdomain":     "k8sd",
+	"s3_bucket":             "s3",
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/gagno/terraform-provider-manta/internal/functions"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*namingDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*namingDataSource)(nil)
+
+const defaultNamingPattern = "{org}-{env}-{region_abbr}-{workload}-{resource_abbr}-{instance}"
+
+type namingDataSourceModel struct {
+	Pattern       types.String `tfsdk:"pattern"`
+	Components    types.Map    `tfsdk:"components"`
+	ResourceTypes types.List   `tfsdk:"resource_types"`
+	Strict        types.Bool   `tfsdk:"strict"`
+	RegionAbbr    types.String `tfsdk:"region_abbr"`
+	Names         types.Map    `tfsdk:"names"`
+}
+
+type namingDataSource struct {
+	data *mantaProviderData
+}
+
+func NewNamingDataSource() datasource.DataSource {
+	return &namingDataSource{}
+}
+
+func (d *namingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_naming"
+}
+
+func (d *namingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates resource names from an organization naming pattern, abbreviating regions and resource types and applying each resource type's naming rules.",
+		Attributes: map[string]schema.Attribute{
+			"pattern": schema.StringAttribute{
+				Description: "The naming pattern. Placeholders in braces are replaced by components of the same name, plus {region_abbr} (the abbreviation of the region component) and {resource_abbr}. Defaults to " + defaultNamingPattern + ".",
+				Optional:    true,
+				Computed:    true,
+			},
+			"components": schema.MapAttribute{
+				Description: "Values for the pattern placeholders, e.g. org, env, region, workload and instance.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"resource_types": schema.ListAttribute{
+				Description: "The resource types to generate names for. Each must be a target supported by the resource_name function, e.g. s3_bucket or azure_storage_account.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"strict": schema.BoolAttribute{
+				Description: "Fail instead of sanitizing or shortening a name that does not satisfy its resource type's rules.",
+				Optional:    true,
+			},
+			"region_abbr": schema.StringAttribute{
+				Description: "The abbreviation used for the region component, if any.",
+				Computed:    true,
+			},
+			"names": schema.MapAttribute{
+				Description: "The generated names, keyed by resource type.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *namingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*mantaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *mantaProviderData, got: %T.", req.ProviderData),
+		)
+		return
+	}
+	d.data = data
+}
+
+func (d *namingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state namingDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Pattern.IsNull() {
+		state.Pattern = types.StringValue(defaultNamingPattern)
+	}
+
+	var components map[string]string
+	resp.Diagnostics.Append(state.Components.ElementsAs(ctx, &components, false)...)
+	var resourceTypes []string
+	resp.Diagnostics.Append(state.ResourceTypes.ElementsAs(ctx, &resourceTypes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := d.data
+	if data == nil {
+		data = newMantaProviderData(nil, nil)
+	}
+
+	naming := namingRequest{
+		Pattern:       state.Pattern.ValueString(),
+		Components:    components,
+		ResourceTypes: resourceTypes,
+		Strict:        state.Strict.ValueBool(),
+		Regions:       data.RegionAbbreviations,
+		Resources:     data.ResourceAbbreviations,
+	}
+	names, regionAbbr, err := naming.generate()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to generate names", err.Error())
+		return
+	}
+
+	state.RegionAbbr = types.StringNull()
+	if regionAbbr != "" {
+		state.RegionAbbr = types.StringValue(regionAbbr)
+	}
+
+	namesValue, diags := types.MapValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Names = namesValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// namingRequest holds everything needed to render a naming pattern.
+type namingRequest struct {
+	Pattern       string
+	Components    map[string]string
+	ResourceTypes []string
+	Strict        bool
+	Regions       map[string]string
+	Resources     map[string]string
+}
+
+var namingPlaceholder = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// generate renders the pattern once per resource type and passes the result
+// through that type's naming rules. It also returns the region abbreviation
+// used, if the components include a region.
+func (n namingRequest) generate() (map[string]string, string, error) {
+	var regionAbbr string
+	if region, ok := n.Components["region"]; ok {
+		abbr, found := n.Regions[strings.ToLower(region)]
+		if !found && strings.Contains(n.Pattern, "{region_abbr}") {
+			return nil, "", fmt.Errorf("no abbreviation for region %q; add it to region_abbreviations in the provider configuration", region)
+		}
+		regionAbbr = abbr
+	} else if strings.Contains(n.Pattern, "{region_abbr}") {
+		return nil, "", errors.New("pattern uses {region_abbr} but components has no region")
+	}
+
+	names := make(map[string]string, len(n.ResourceTypes))
+	for _, resourceType := range n.ResourceTypes {
+		rendered, err := n.render(resourceType, regionAbbr)
+		if err != nil {
+			return nil, "", err
+		}
+
+		name, err := functions.ResourceName([]string{rendered}, resourceType)
+		if err != nil {
+			return nil, "", fmt.Errorf("resource type %s: %w", resourceType, err)
+		}
+		if n.Strict && name != rendered {
+			return nil, "", fmt.Errorf("resource type %s: name %q does not satisfy its naming rules (it would become %q)", resourceType, rendered, name)
+		}
+		names[resourceType] = name
+	}
+	return names, regionAbbr, nil
+}
+
+func (n namingRequest) render(resourceType, regionAbbr string) (string, error) {
+	var missing []string
+	rendered := namingPlaceholder.ReplaceAllStringFunc(n.Pattern, func(m string) string {
+		key := m[1 : len(m)-1]
+		switch key {
+		case "region_abbr":
+			return regionAbbr
+		case "resource_abbr":
+			abbr, ok := n.Resources[resourceType]
+			if !ok {
+				missing = append(missing, fmt.Sprintf("no abbreviation for resource type %q; add it to resource_abbreviations in the provider configuration", resourceType))
+			}
+			return abbr
+		}
+		value, ok := n.Components[key]
+		if !ok {
+			missing = append(missing, fmt.Sprintf("pattern uses {%s} but components has no %s", key, key))
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", errors.New(missing[0])
+	}
+	return rendered, nil
+}
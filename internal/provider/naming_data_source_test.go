@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNamingRequest_Generate(t *testing.T) {
+	components := map[string]string{
+		"org":      "acme",
+		"env":      "prod",
+		"region":   "westeurope",
+		"workload": "payments",
+		"instance": "01",
+	}
+	defaults := newMantaProviderData(nil, nil)
+
+	tests := []struct {
+		name       string
+		req        namingRequest
+		want       map[string]string
+		wantRegion string
+		wantErr    string
+	}{
+		{
+			name: "default pattern",
+			req: namingRequest{
+				Pattern:       defaultNamingPattern,
+				Components:    components,
+				ResourceTypes: []string{"azure_key_vault", "azure_storage_account", "s3_bucket"},
+			},
+			want: map[string]string{
+				"azure_key_vault":       "acme-prod-weu-p-b8894f3f", // shortened to 24 characters
+				"azure_storage_account": "acmeprodweupaymentsst01",
+				"s3_bucket":             "acme-prod-weu-payments-s3-01",
+			},
+			wantRegion: "weu",
+		},
+		{
+			name: "custom pattern",
+			req: namingRequest{
+				Pattern:       "{resource_abbr}-{workload}-{env}",
+				Components:    components,
+				ResourceTypes: []string{"k8s_dns_label"},
+			},
+			want:       map[string]string{"k8s_dns_label": "k8s-payments-prod"},
+			wantRegion: "weu",
+		},
+		{
+			name: "unknown region unused by pattern",
+			req: namingRequest{
+				Pattern:       "{org}-{env}",
+				Components:    map[string]string{"org": "acme", "env": "dev", "region": "mars-1"},
+				ResourceTypes: []string{"s3_bucket"},
+			},
+			want: map[string]string{"s3_bucket": "acme-dev"},
+		},
+		{
+			name: "unknown region",
+			req: namingRequest{
+				Pattern:       defaultNamingPattern,
+				Components:    map[string]string{"org": "acme", "env": "dev", "region": "mars-1", "workload": "x", "instance": "1"},
+				ResourceTypes: []string{"s3_bucket"},
+			},
+			wantErr: "no abbreviation for region",
+		},
+		{
+			name: "missing component",
+			req: namingRequest{
+				Pattern:       "{org}-{team}",
+				Components:    components,
+				ResourceTypes: []string{"s3_bucket"},
+			},
+			wantErr: "components has no team",
+		},
+		{
+			name: "unsupported resource type",
+			req: namingRequest{
+				Pattern:       "{org}-{env}",
+				Components:    components,
+				ResourceTypes: []string{"dynamodb_table"},
+			},
+			wantErr: "unsupported target",
+		},
+		{
+			name: "strict rejects sanitized name",
+			req: namingRequest{
+				Pattern:       defaultNamingPattern,
+				Components:    components,
+				ResourceTypes: []string{"azure_storage_account"},
+				Strict:        true,
+			},
+			wantErr: "does not satisfy its naming rules",
+		},
+		{
+			name: "strict accepts valid name",
+			req: namingRequest{
+				Pattern:       defaultNamingPattern,
+				Components:    components,
+				ResourceTypes: []string{"s3_bucket"},
+				Strict:        true,
+			},
+			want:       map[string]string{"s3_bucket": "acme-prod-weu-payments-s3-01"},
+			wantRegion: "weu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Regions = defaults.RegionAbbreviations
+			tt.req.Resources = defaults.ResourceAbbreviations
+
+			got, region, err := tt.req.generate()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("generate() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("generate() unexpected error: %s", err)
+			}
+			if region != tt.wantRegion {
+				t.Errorf("region abbreviation = %q, want %q", region, tt.wantRegion)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("generate() = %v, want %v", got, tt.want)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("names[%q] = %q, want %q", k, got[k], v)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultAbbreviations_Unique(t *testing.T) {
+	for table, abbreviations := range map[string]map[string]string{
+		"region":   defaultRegionAbbreviations,
+		"resource": defaultResourceAbbreviations,
+	} {
+		seen := map[string]string{}
+		for name, abbr := range abbreviations {
+			if other, ok := seen[abbr]; ok {
+				t.Errorf("%s abbreviation %q is used by both %s and %s", table, abbr, other, name)
+			}
+			seen[abbr] = name
+		}
+	}
+}
+
+func TestNewMantaProviderData_Overrides(t *testing.T) {
+	data := newMantaProviderData(
+		map[string]string{"WestEurope": "we", "mars-1": "mrs1"},
+		map[string]string{"azure_key_vault": "vault"},
+	)
+
+	if got := data.RegionAbbreviations["westeurope"]; got != "we" {
+		t.Errorf("westeurope = %q, want %q", got, "we")
+	}
+	if got := data.RegionAbbreviations["mars-1"]; got != "mrs1" {
+		t.Errorf("mars-1 = %q, want %q", got, "mrs1")
+	}
+	if got := data.RegionAbbreviations["eastus"]; got != "eus" {
+		t.Errorf("built-in eastus = %q, want %q", got, "eus")
+	}
+	if got := data.ResourceAbbreviations["azure_key_vault"]; got != "vault" {
+		t.Errorf("azure_key_vault = %q, want %q", got, "vault")
+	}
+	if got := defaultRegionAbbreviations["westeurope"]; got != "weu" {
+		t.Errorf("built-in table modified: westeurope = %q", got)
+	}
+}
+
+func TestNamingDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	d := NewNamingDataSource()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %s", schemaResp.Diagnostics)
+	}
+
+	var configureResp datasource.ConfigureResponse
+	d.(datasource.DataSourceWithConfigure).Configure(ctx, datasource.ConfigureRequest{
+		ProviderData: newMantaProviderData(nil, map[string]string{"s3_bucket": "bkt"}),
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %s", configureResp.Diagnostics)
+	}
+
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	strMap := tftypes.Map{ElementType: tftypes.String}
+	raw := tftypes.NewValue(objType, map[string]tftypes.Value{
+		"pattern": tftypes.NewValue(tftypes.String, nil),
+		"components": tftypes.NewValue(strMap, map[string]tftypes.Value{
+			"org":      tftypes.NewValue(tftypes.String, "acme"),
+			"env":      tftypes.NewValue(tftypes.String, "prod"),
+			"region":   tftypes.NewValue(tftypes.String, "eu-west-1"),
+			"workload": tftypes.NewValue(tftypes.String, "logs"),
+			"instance": tftypes.NewValue(tftypes.String, "01"),
+		}),
+		"resource_types": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "s3_bucket"),
+		}),
+		"strict":      tftypes.NewValue(tftypes.Bool, nil),
+		"region_abbr": tftypes.NewValue(tftypes.String, nil),
+		"names":       tftypes.NewValue(strMap, nil),
+	})
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %s", resp.Diagnostics)
+	}
+
+	var state namingDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected state diagnostics: %s", resp.Diagnostics)
+	}
+
+	if state.Pattern.ValueString() != defaultNamingPattern {
+		t.Errorf("pattern = %q, want default", state.Pattern.ValueString())
+	}
+	if state.RegionAbbr.ValueString() != "euw1" {
+		t.Errorf("region_abbr = %q, want %q", state.RegionAbbr.ValueString(), "euw1")
+	}
+	names := state.Names.Elements()
+	if got, ok := names["s3_bucket"].(types.String); !ok || got.ValueString() != "acme-prod-euw1-logs-bkt-01" {
+		t.Errorf("names[s3_bucket] = %v, want %q", names["s3_bucket"], "acme-prod-euw1-logs-bkt-01")
+	}
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gagno/terraform-provider-manta/internal/functions"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -16,7 +17,37 @@ var _ provider.Provider = (*mantaProvider)(nil)
 var _ provider.ProviderWithFunctions = (*mantaProvider)(nil)
 
 type mantaProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
+	Endpoint              types.String `tfsdk:"endpoint"`
+	RegionAbbreviations   types.Map    `tfsdk:"region_abbreviations"`
+	ResourceAbbreviations types.Map    `tfsdk:"resource_abbreviations"`
+}
+
+// mantaProviderData is handed to data sources once the provider is configured.
+type mantaProviderData struct {
+	RegionAbbreviations   map[string]string
+	ResourceAbbreviations map[string]string
+}
+
+// newMantaProviderData merges the configured abbreviation overrides over the
+// built-in tables. Region keys are matched case-insensitively.
+func newMantaProviderData(regions, resources map[string]string) *mantaProviderData {
+	data := &mantaProviderData{
+		RegionAbbreviations:   make(map[string]string, len(defaultRegionAbbreviations)+len(regions)),
+		ResourceAbbreviations: make(map[string]string, len(defaultResourceAbbreviations)+len(resources)),
+	}
+	for k, v := range defaultRegionAbbreviations {
+		data.RegionAbbreviations[k] = v
+	}
+	for k, v := range regions {
+		data.RegionAbbreviations[strings.ToLower(k)] = v
+	}
+	for k, v := range defaultResourceAbbreviations {
+		data.ResourceAbbreviations[k] = v
+	}
+	for k, v := range resources {
+		data.ResourceAbbreviations[k] = v
+	}
+	return data
 }
 
 type mantaProvider struct {
@@ -37,6 +68,16 @@ func (p *mantaProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"endpoint": schema.StringAttribute{
 				Optional: true,
 			},
+			"region_abbreviations": schema.MapAttribute{
+				Description: "Region abbreviations used by the manta_naming data source, added to or replacing the built-in table.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"resource_abbreviations": schema.MapAttribute{
+				Description: "Resource type abbreviations used by the manta_naming data source, added to or replacing the built-in table.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -51,6 +92,21 @@ func (p *mantaProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.Endpoint.IsNull() {
 		p.endpoint = config.Endpoint.ValueString()
 	}
+
+	// Overrides that are unset, or not yet known during planning, leave the
+	// built-in tables in place.
+	var regions, resources map[string]string
+	if !config.RegionAbbreviations.IsNull() && !config.RegionAbbreviations.IsUnknown() {
+		resp.Diagnostics.Append(config.RegionAbbreviations.ElementsAs(ctx, &regions, false)...)
+	}
+	if !config.ResourceAbbreviations.IsNull() && !config.ResourceAbbreviations.IsUnknown() {
+		resp.Diagnostics.Append(config.ResourceAbbreviations.ElementsAs(ctx, &resources, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.DataSourceData = newMantaProviderData(regions, resources)
 }
 
 func (p *mantaProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -58,15 +114,60 @@ func (p *mantaProvider) Resources(_ context.Context) []func() resource.Resource
 }
 
 func (p *mantaProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewNamingDataSource,
+	}
 }
 
 func (p *mantaProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		functions.NewAlignColumnsFunction,
+		functions.NewCIDRContainsFunction,
+		functions.NewCIDRExcludeFunction,
+		functions.NewCIDRHostsFunction,
+		functions.NewCIDRMergeFunction,
+		functions.NewCIDROverlapsFunction,
+		functions.NewCIDRPlanFunction,
+		functions.NewClosestMatchFunction,
+		functions.NewConvertCaseFunction,
+		functions.NewConvertKeysCaseFunction,
+		functions.NewCronNextFunction,
+		functions.NewCronValidateFunction,
+		functions.NewDedentFunction,
 		functions.NewDeepMergeFunction,
+		functions.NewDetectSecretsFunction,
+		functions.NewDurationParseFunction,
+		functions.NewGraphemeLengthFunction,
+		functions.NewIntToIPFunction,
+		functions.NewIPRangeToCIDRsFunction,
+		functions.NewIPToIntFunction,
+		functions.NewIPv6EUI64Function,
+		functions.NewIPv6NAT64Function,
+		functions.NewIPv6NormalizeFunction,
 		functions.NewIsPalindromeFunction,
+		functions.NewK8sLabelKeyFunction,
+		functions.NewK8sLabelKeyValidateFunction,
+		functions.NewK8sLabelValueFunction,
+		functions.NewK8sLabelValueValidateFunction,
 		functions.NewMaskFunction,
+		functions.NewMaskFormatFunction,
+		functions.NewNormalizeUnicodeFunction,
+		functions.NewPalindromeAnalyzeFunction,
+		functions.NewPetNameFunction,
+		functions.NewPseudonymizeFunction,
+		functions.NewRedactSecretsFunction,
+		functions.NewResourceNameFunction,
+		functions.NewReverseDNSNameFunction,
+		functions.NewSemverCoerceFunction,
 		functions.NewSemverCompareFunction,
+		functions.NewSemverConstraintsIntersectFunction,
+		functions.NewSemverConstraintsOverlapFunction,
+		functions.NewSlugifyFunction,
+		functions.NewStringDistanceFunction,
+		functions.NewTimeAddCalendarFunction,
+		functions.NewTimeConvertFunction,
+		functions.NewTimeFormatFunction,
 		functions.NewTruncateFunction,
+		functions.NewWrapFunction,
 	}
 }
@@ -4,8 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestMantaProvider_Metadata(t *testing.T) {
@@ -39,6 +42,64 @@ func TestMantaProvider_Schema(t *testing.T) {
 	if attr.IsRequired() {
 		t.Error("endpoint attribute should be optional, not required")
 	}
+
+	for _, name := range []string{"region_abbreviations", "resource_abbreviations"} {
+		attr, ok := resp.Schema.Attributes[name]
+		if !ok {
+			t.Fatalf("schema missing %q attribute", name)
+		}
+		if attr.IsRequired() {
+			t.Errorf("%s attribute should be optional, not required", name)
+		}
+	}
+}
+
+func TestMantaProvider_ConfigureUnknownAbbreviations(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	strMap := tftypes.Map{ElementType: tftypes.String}
+	raw := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"endpoint":               tftypes.NewValue(tftypes.String, nil),
+		"region_abbreviations":   tftypes.NewValue(strMap, tftypes.UnknownValue),
+		"resource_abbreviations": tftypes.NewValue(strMap, map[string]tftypes.Value{"s3_bucket": tftypes.NewValue(tftypes.String, "bkt")}),
+	})
+
+	req := provider.ConfigureRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, req, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", resp.Diagnostics)
+	}
+	data, ok := resp.DataSourceData.(*mantaProviderData)
+	if !ok {
+		t.Fatalf("DataSourceData = %T, want *mantaProviderData", resp.DataSourceData)
+	}
+	if got := data.RegionAbbreviations["eastus"]; got != "eus" {
+		t.Errorf("built-in eastus = %q, want %q", got, "eus")
+	}
+	if got := data.ResourceAbbreviations["s3_bucket"]; got != "bkt" {
+		t.Errorf("s3_bucket = %q, want %q", got, "bkt")
+	}
+}
+
+func TestMantaProvider_DataSources(t *testing.T) {
+	p := New()
+
+	registered := make(map[string]bool)
+	for _, ds := range p.DataSources(context.Background()) {
+		var metaResp datasource.MetadataResponse
+		ds().Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "manta"}, &metaResp)
+		registered[metaResp.TypeName] = true
+	}
+
+	if !registered["manta_naming"] {
+		t.Error("data source \"manta_naming\" not found in provider data sources")
+	}
 }
 
 func TestMantaProvider_Functions(t *testing.T) {
@@ -60,7 +121,56 @@ func TestMantaProvider_Functions(t *testing.T) {
 		registered[metaResp.Name] = true
 	}
 
-	expected := []string{"deep_merge", "is_palindrome", "mask", "semver_compare", "truncate"}
+	expected := []string{
+		"align_columns",
+		"cidr_contains",
+		"cidr_exclude",
+		"cidr_hosts",
+		"cidr_merge",
+		"cidr_overlaps",
+		"cidr_plan",
+		"closest_match",
+		"convert_case",
+		"convert_keys_case",
+		"cron_next",
+		"cron_validate",
+		"dedent",
+		"deep_merge",
+		"detect_secrets",
+		"duration_parse",
+		"grapheme_length",
+		"int_to_ip",
+		"ip_range_to_cidrs",
+		"ip_to_int",
+		"ipv6_eui64",
+		"ipv6_nat64",
+		"ipv6_normalize",
+		"is_palindrome",
+		"k8s_label_key",
+		"k8s_label_key_validate",
+		"k8s_label_value",
+		"k8s_label_value_validate",
+		"mask",
+		"mask_format",
+		"normalize_unicode",
+		"palindrome_analyze",
+		"pet_name",
+		"pseudonymize",
+		"redact_secrets",
+		"resource_name",
+		"reverse_dns_name",
+		"semver_coerce",
+		"semver_compare",
+		"semver_constraints_intersect",
+		"semver_constraints_overlap",
+		"slugify",
+		"string_distance",
+		"time_add_calendar",
+		"time_convert",
+		"time_format",
+		"truncate",
+		"wrap",
+	}
 	for _, name := range expected {
 		if !registered[name] {
 			t.Errorf("function %q not found in provider functions", name)